@@ -3,6 +3,7 @@ package balancers
 import (
 	"sort"
 	"strings"
+	"time"
 
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
@@ -24,6 +25,70 @@ func SingleConn() *balancerConfig.Config {
 	}
 }
 
+const defaultLoadFactorDamping = 0.5
+
+// LeastLatency creates latency-aware balancer, which chooses better endpoint from two random endpoints
+// (power of two choices) by EWMA of round trip time and count of inflight calls.
+// LeastLatency balancer may be used as "balancer" argument of PreferLocalDC, PreferLocations and other
+// prefer balancers, then endpoints are chosen from preferred endpoints.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func LeastLatency() *balancerConfig.Config {
+	return &balancerConfig.Config{
+		LeastLatency: true,
+	}
+}
+
+// P2C is an alias of LeastLatency
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func P2C() *balancerConfig.Config {
+	return LeastLatency()
+}
+
+// WithLatencyDecay defines decay time of EWMA of round trip time for LeastLatency balancer.
+// Lower decay makes balancer more sensitive to latency changes, default decay is 10s.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithLatencyDecay(balancer *balancerConfig.Config, decay time.Duration) *balancerConfig.Config {
+	balancer.LatencyDecay = decay
+
+	return balancer
+}
+
+// WeightedByLoadFactor creates balancer, which chooses random endpoint with weight by load factor of endpoint.
+// Load factors are reported by discovery, endpoints with lower load factor get more calls.
+// Load factors are smoothed between discovery rounds for prevent flapping (see WithLoadFactorDamping).
+// WeightedByLoadFactor balancer may be used as "balancer" argument of PreferLocalDC, PreferLocations and other
+// prefer balancers, also weights may be combined with LeastLatency balancer.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WeightedByLoadFactor() *balancerConfig.Config {
+	return WithLoadFactorDamping(RandomChoice(), defaultLoadFactorDamping)
+}
+
+// WithLoadFactorDamping enables weights by load factors and defines damping of load factors.
+// Damping in [0, 1) is weight of previous load factor of endpoint on each discovery round,
+// zero damping means load factors are not smoothed. Default damping is 0.5
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithLoadFactorDamping(balancer *balancerConfig.Config, damping float64) *balancerConfig.Config {
+	balancer.LoadFactorWeighted = true
+	balancer.LoadFactorDamping = damping
+
+	return balancer
+}
+
 type filterLocalDC struct{}
 
 func (filterLocalDC) Allow(info balancerConfig.Info, c conn.Conn) bool {
@@ -2,6 +2,7 @@ package balancers
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -69,3 +70,22 @@ func applyPreferFilter(info balancerConfig.Info, b *balancerConfig.Config, conns
 
 	return res
 }
+
+func TestWithOutlierDetection(t *testing.T) {
+	b := WithOutlierDetection(PreferLocalDC(LeastLatency()),
+		WithErrorRate(0.5, 20, time.Minute),
+		WithEjectionTime(time.Second, time.Minute),
+		WithMaxEjectedPercent(30),
+	)
+	require.True(t, b.LeastLatency)
+	require.True(t, b.DetectLocalDC)
+	require.Equal(t, &balancerConfig.OutlierDetection{
+		ConsecutiveFailures: 5,
+		ErrorRate:           0.5,
+		MinRequests:         20,
+		Window:              time.Minute,
+		BaseEjectionTime:    time.Second,
+		MaxEjectionTime:     time.Minute,
+		MaxEjectedPercent:   30,
+	}, b.OutlierDetection)
+}
@@ -15,6 +15,9 @@ const (
 	typeRandomChoice = balancerType("random_choice")
 	typeSingle       = balancerType("single")
 	typeDisable      = balancerType("disable")
+	typeLeastLatency = balancerType("least_latency")
+	typeP2C          = balancerType("p2c")
+	typeLoadFactor   = balancerType("load_factor")
 )
 
 type preferType string
@@ -60,6 +63,10 @@ func createByType(t balancerType) (*balancerConfig.Config, error) {
 		return RandomChoice(), nil
 	case typeRoundRobin:
 		return RoundRobin(), nil
+	case typeLeastLatency, typeP2C:
+		return LeastLatency(), nil
+	case typeLoadFactor:
+		return WeightedByLoadFactor(), nil
 	default:
 		return nil, xerrors.WithStackTrace(fmt.Errorf("unknown type of balancer: %s", t))
 	}
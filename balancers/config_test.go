@@ -63,6 +63,44 @@ func TestFromConfig(t *testing.T) {
 			}`,
 			res: balancerConfig.Config{},
 		},
+		{
+			name:   "least_latency",
+			config: `least_latency`,
+			res:    balancerConfig.Config{LeastLatency: true},
+		},
+		{
+			name: "load_factor/JSON",
+			config: `{
+				"type": "load_factor",
+				"prefer": "locations",
+				"locations": ["AAA"],
+				"fallback": true
+			}`,
+			res: balancerConfig.Config{
+				LoadFactorWeighted: true,
+				LoadFactorDamping:  0.5,
+				AllowFallback:      true,
+				Filter: filterFunc(func(info balancerConfig.Info, c conn.Conn) bool {
+					// some non nil func
+					return false
+				}),
+			},
+		},
+		{
+			name: "p2c/JSON",
+			config: `{
+				"type": "p2c",
+				"prefer": "local_dc"
+			}`,
+			res: balancerConfig.Config{
+				LeastLatency:  true,
+				DetectLocalDC: true,
+				Filter: filterFunc(func(info balancerConfig.Info, c conn.Conn) bool {
+					// some non nil func
+					return false
+				}),
+			},
+		},
 		{
 			name: "prefer_local_dc",
 			config: `{
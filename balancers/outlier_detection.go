@@ -0,0 +1,83 @@
+package balancers
+
+import (
+	"time"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+)
+
+const defaultConsecutiveFailures = 5
+
+// OutlierDetectionOption is option for WithOutlierDetection
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type OutlierDetectionOption func(c *balancerConfig.OutlierDetection)
+
+// WithConsecutiveFailures defines count of consecutive failed calls for ejection of endpoint,
+// zero disables check of consecutive failures. Default count is 5
+func WithConsecutiveFailures(count int) OutlierDetectionOption {
+	return func(c *balancerConfig.OutlierDetection) {
+		c.ConsecutiveFailures = count
+	}
+}
+
+// WithErrorRate defines rate of failed calls in sliding window for ejection of endpoint.
+// Error rate is checked only if count of calls in window is not less than minRequests.
+// By default check of error rate is disabled, default window is 10s
+func WithErrorRate(rate float64, minRequests int, window time.Duration) OutlierDetectionOption {
+	return func(c *balancerConfig.OutlierDetection) {
+		c.ErrorRate = rate
+		c.MinRequests = minRequests
+		c.Window = window
+	}
+}
+
+// WithSlowCallDuration defines duration of call, which is counted as failed call.
+// Slow calls make possible ejection of slow-but-alive endpoints. By default slow calls are not checked
+func WithSlowCallDuration(d time.Duration) OutlierDetectionOption {
+	return func(c *balancerConfig.OutlierDetection) {
+		c.SlowCallDuration = d
+	}
+}
+
+// WithEjectionTime defines ejection time of endpoint. Ejection time is base on first ejection and doubles
+// on each next ejection up to maxEjectionTime. Default base ejection time is 30s, default max ejection time is 5m
+func WithEjectionTime(base, maxEjectionTime time.Duration) OutlierDetectionOption {
+	return func(c *balancerConfig.OutlierDetection) {
+		c.BaseEjectionTime = base
+		c.MaxEjectionTime = maxEjectionTime
+	}
+}
+
+// WithMaxEjectedPercent defines max percent of ejected endpoints of cluster, default percent is 10.
+// One endpoint may be ejected always
+func WithMaxEjectedPercent(percent int) OutlierDetectionOption {
+	return func(c *balancerConfig.OutlierDetection) {
+		c.MaxEjectedPercent = percent
+	}
+}
+
+// WithOutlierDetection enables outlier detection for balancer. Endpoints with many failed or slow calls
+// are ejected from balancing for ejection time. After ejection time endpoint becomes half-open and one
+// probe call decides about return of endpoint to balancing or next ejection with doubled ejection time.
+// Failed calls are calls with transport errors and calls to overloaded or unavailable nodes.
+// If all endpoints are ejected or banned - balancer uses them as last resort.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithOutlierDetection(balancer *balancerConfig.Config, opts ...OutlierDetectionOption) *balancerConfig.Config {
+	c := &balancerConfig.OutlierDetection{
+		ConsecutiveFailures: defaultConsecutiveFailures,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+	balancer.OutlierDetection = c
+
+	return balancer
+}
@@ -12,6 +12,7 @@ import (
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -21,8 +22,12 @@ type Config struct {
 
 	trace          *trace.Driver
 	dialTimeout    time.Duration
+	drainTimeout   time.Duration
 	connectionTTL  time.Duration
+	channels       int
+	streamChannels int
 	balancerConfig *balancerConfig.Config
+	discovery      balancerConfig.Discovery
 	secure         bool
 	endpoint       string
 	database       string
@@ -64,6 +69,24 @@ func (c *Config) ConnectionTTL() time.Duration {
 	return c.connectionTTL
 }
 
+// ChannelsPerEndpoint is a count of grpc connections to each endpoint for unary calls.
+//
+// Calls are routed to least loaded channel of endpoint. Default count is 1.
+func (c *Config) ChannelsPerEndpoint() int {
+	if c.channels < 1 {
+		return 1
+	}
+
+	return c.channels
+}
+
+// StreamingChannelsPerEndpoint is a count of grpc connections to each endpoint for streaming calls.
+//
+// If StreamingChannelsPerEndpoint is zero - streaming calls share channels with unary calls.
+func (c *Config) StreamingChannelsPerEndpoint() int {
+	return c.streamChannels
+}
+
 // Secure is a flag for secure connection.
 func (c *Config) Secure() bool {
 	return c.secure
@@ -87,6 +110,14 @@ func (c *Config) DialTimeout() time.Duration {
 	return c.dialTimeout
 }
 
+// DrainTimeout is a max duration of drain phase of driver close: new calls are rejected
+// and in-flight calls, transactions, topic readers and writers are allowed to finish.
+//
+// If DrainTimeout is less than or equal to zero then drain phase is skipped.
+func (c *Config) DrainTimeout() time.Duration {
+	return c.drainTimeout
+}
+
 // Database is a required database name.
 func (c *Config) Database() string {
 	return c.database
@@ -103,6 +134,12 @@ func (c *Config) Balancer() *balancerConfig.Config {
 	return c.balancerConfig
 }
 
+// Discovery is a source of endpoints for balancer.
+// By default endpoints are discovered with discovery service of YDB.
+func (c *Config) Discovery() balancerConfig.Discovery {
+	return c.discovery
+}
+
 type Option func(c *Config)
 
 // WithInternalDNSResolver
@@ -161,6 +198,12 @@ func WithTraceRetry(t *trace.Retry, opts ...trace.RetryComposeOption) Option {
 	}
 }
 
+func WithRetryBudget(b *retry.Budget) Option {
+	return func(c *Config) {
+		config.SetRetryBudget(&c.Common, b)
+	}
+}
+
 func WithUserAgent(userAgent string) Option {
 	return func(c *Config) {
 		c.metaOptions = append(c.metaOptions, meta.WithUserAgentOption(userAgent))
@@ -173,6 +216,18 @@ func WithConnectionTTL(ttl time.Duration) Option {
 	}
 }
 
+func WithChannelsPerEndpoint(channels int) Option {
+	return func(c *Config) {
+		c.channels = channels
+	}
+}
+
+func WithStreamingChannelsPerEndpoint(channels int) Option {
+	return func(c *Config) {
+		c.streamChannels = channels
+	}
+}
+
 func WithCredentials(credentials credentials.Credentials) Option {
 	return func(c *Config) {
 		c.credentials = credentials
@@ -223,12 +278,53 @@ func WithDialTimeout(timeout time.Duration) Option {
 	}
 }
 
+func WithDrainTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		c.drainTimeout = timeout
+	}
+}
+
 func WithBalancer(balancer *balancerConfig.Config) Option {
 	return func(c *Config) {
 		c.balancerConfig = balancer
 	}
 }
 
+// WithStaticDiscovery replaces discovery service with static list of endpoints (host:port).
+// If endpoints are empty - endpoint of driver is used. Node IDs of endpoints are resolved lazily
+// with discovery service of any endpoint
+func WithStaticDiscovery(endpoints ...string) Option {
+	return func(c *Config) {
+		c.discovery = balancerConfig.Discovery{
+			Type:      balancerConfig.DiscoveryStatic,
+			Endpoints: endpoints,
+		}
+	}
+}
+
+// WithDNSDiscovery replaces discovery service with resolving of endpoints from A/AAAA records
+// of hosts (host:port). If hosts are empty - endpoint of driver is used. Node IDs of endpoints are
+// resolved lazily with discovery service of any endpoint
+func WithDNSDiscovery(hosts ...string) Option {
+	return func(c *Config) {
+		c.discovery = balancerConfig.Discovery{
+			Type:      balancerConfig.DiscoveryDNS,
+			Endpoints: hosts,
+		}
+	}
+}
+
+// WithDNSSRVDiscovery replaces discovery service with resolving of endpoints from SRV records.
+// Node IDs of endpoints are resolved lazily with discovery service of any endpoint
+func WithDNSSRVDiscovery(names ...string) Option {
+	return func(c *Config) {
+		c.discovery = balancerConfig.Discovery{
+			Type:      balancerConfig.DiscoveryDNSSRV,
+			Endpoints: names,
+		}
+	}
+}
+
 func WithRequestsType(requestsType string) Option {
 	return func(c *Config) {
 		c.metaOptions = append(c.metaOptions, meta.WithRequestTypeOption(requestsType))
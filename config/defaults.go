@@ -92,6 +92,11 @@ func defaultConfig() (c *Config) {
 		balancerConfig: balancers.Default(),
 		tlsConfig:      defaultTLSConfig(),
 		dialTimeout:    DefaultDialTimeout,
+		drainTimeout:   DefaultDrainTimeout,
 		trace:          &trace.Driver{},
 	}
 }
+
+// DefaultDrainTimeout is default timeout of drain of in-flight calls on close of driver.
+// Drain phase is disabled by default and enabled with ydb.WithDrainTimeout
+var DefaultDrainTimeout = time.Duration(0)
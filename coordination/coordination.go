@@ -2,13 +2,151 @@ package coordination
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 )
 
+var (
+	// ErrSessionClosed returned from methods of closed or lost session
+	ErrSessionClosed = xerrors.Wrap(errors.New("ydb: coordination session closed"))
+
+	// ErrAcquireTimeout returned from Session.AcquireSemaphore if semaphore not acquired
+	// during acquire timeout (see options.WithAcquireTimeout)
+	ErrAcquireTimeout = xerrors.Wrap(errors.New("ydb: coordination semaphore acquire timeout"))
+)
+
 type Client interface {
 	CreateNode(ctx context.Context, path string, config NodeConfig) (err error)
 	AlterNode(ctx context.Context, path string, config NodeConfig) (err error)
 	DropNode(ctx context.Context, path string) (err error)
 	DescribeNode(ctx context.Context, path string) (_ *scheme.Entry, _ *NodeConfig, err error)
+
+	// Session starts new session of coordination node with path.
+	//
+	// Session keeps alive in background and reconnects with same session ID after transport errors.
+	// Session is lost if it can't reconnect during session timeout, Session.Context is cancelled in this case.
+	//
+	// # Experimental
+	//
+	// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+	Session(ctx context.Context, path string, opts ...options.SessionOption) (Session, error)
+}
+
+// Session is a session of coordination node with semaphores API
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Session interface {
+	// Close stops the session and releases all semaphores, acquired by the session
+	Close(ctx context.Context) error
+
+	// Context returns context which cancelled when session closed or lost
+	Context() context.Context
+
+	// SessionID returns server side session ID, it is same after reconnects
+	SessionID() uint64
+
+	// CreateSemaphore creates persistent semaphore with limit
+	CreateSemaphore(ctx context.Context, name string, limit uint64, opts ...options.CreateSemaphoreOption) error
+
+	// UpdateSemaphore updates data of semaphore
+	UpdateSemaphore(ctx context.Context, name string, opts ...options.UpdateSemaphoreOption) error
+
+	// DeleteSemaphore deletes semaphore
+	DeleteSemaphore(ctx context.Context, name string, opts ...options.DeleteSemaphoreOption) error
+
+	// DescribeSemaphore returns state of semaphore
+	DescribeSemaphore(
+		ctx context.Context,
+		name string,
+		opts ...options.DescribeSemaphoreOption,
+	) (*SemaphoreDescription, error)
+
+	// WatchSemaphore returns channel with actual states of semaphore. First value is current state,
+	// next values sent after changes of data or owners of semaphore.
+	// Channel closed after ctx cancelled or session closed.
+	WatchSemaphore(
+		ctx context.Context,
+		name string,
+		opts ...options.DescribeSemaphoreOption,
+	) (<-chan *SemaphoreDescription, error)
+
+	// AcquireSemaphore acquires count of semaphore tokens.
+	// Acquire of ephemeral semaphore (see options.WithEphemeral) creates the semaphore if it not exists,
+	// ephemeral semaphore deleted after release by last owner.
+	// Repeated acquire of same semaphore by the session changes acquired count and data.
+	//
+	// AcquireSemaphore returns ErrAcquireTimeout if acquire timeout (see options.WithAcquireTimeout) expired.
+	AcquireSemaphore(
+		ctx context.Context,
+		name string,
+		count uint64,
+		opts ...options.AcquireSemaphoreOption,
+	) (Lease, error)
+}
+
+// Lease is acquired semaphore
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Lease interface {
+	// Context returns context which cancelled when lease released or session closed or lost
+	Context() context.Context
+
+	// Release releases the semaphore
+	Release(ctx context.Context) error
+
+	// Session returns session of the lease
+	Session() Session
+}
+
+// SemaphoreDescription is state of semaphore
+type SemaphoreDescription struct {
+	Name      string
+	Data      []byte
+	Count     uint64
+	Limit     uint64
+	Ephemeral bool
+	Owners    []*SemaphoreSession
+	Waiters   []*SemaphoreSession
+}
+
+func (d *SemaphoreDescription) String() string {
+	return fmt.Sprintf("{Name: %q Limit: %d Count: %d Ephemeral: %t Data: %q Owners: %s Waiters: %s}",
+		d.Name, d.Limit, d.Count, d.Ephemeral, d.Data, semaphoreSessionsToString(d.Owners),
+		semaphoreSessionsToString(d.Waiters),
+	)
+}
+
+// SemaphoreSession is owner or waiter of semaphore
+type SemaphoreSession struct {
+	// OrderID is order of acquire attempts
+	OrderID   uint64
+	SessionID uint64
+	Timeout   time.Duration
+	Count     uint64
+	Data      []byte
+}
+
+func (s *SemaphoreSession) String() string {
+	return fmt.Sprintf("{OrderID: %d SessionID: %d Timeout: %v Count: %d Data: %q}",
+		s.OrderID, s.SessionID, s.Timeout, s.Count, s.Data,
+	)
+}
+
+func semaphoreSessionsToString(sessions []*SemaphoreSession) string {
+	res := make([]string, len(sessions))
+	for i, s := range sessions {
+		res[i] = s.String()
+	}
+
+	return "[" + strings.Join(res, " ") + "]"
 }
@@ -0,0 +1,170 @@
+package coordination
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Leader is current leader of LeaderElection
+type Leader struct {
+	// SessionID is ID of leader session
+	SessionID uint64
+
+	// Payload is data of the leader, which passed to NewLeaderElection
+	Payload []byte
+}
+
+// LeaderElection is leader election over persistent semaphore with limit 1.
+// Leader is a session which owns the semaphore, payload of candidate is data of the semaphore owner.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type LeaderElection struct {
+	session Session
+	name    string
+	payload []byte
+
+	mutex sync.Mutex
+	lease Lease
+}
+
+// NewLeaderElection makes leader election with name over session.
+// Payload is available to other sessions while the session is leader.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func NewLeaderElection(session Session, name string, payload []byte) *LeaderElection {
+	return &LeaderElection{
+		session: session,
+		name:    name,
+		payload: payload,
+	}
+}
+
+// Campaign waits until the session becomes leader or ctx done, cancel ctx to stop campaign.
+// Campaign returns context which cancelled when leadership is lost: after Resign, session close or session lost.
+func (e *LeaderElection) Campaign(ctx context.Context) (context.Context, error) {
+	e.mutex.Lock()
+	lease := e.lease
+	e.mutex.Unlock()
+
+	if lease != nil && lease.Context().Err() == nil {
+		return lease.Context(), nil
+	}
+
+	if err := createSemaphoreIfNotExists(ctx, e.session, e.name, 1); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	lease, err := e.session.AcquireSemaphore(ctx, e.name, 1, options.WithAcquireData(e.payload))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	e.mutex.Lock()
+	e.lease = lease
+	e.mutex.Unlock()
+
+	return lease.Context(), nil
+}
+
+// Resign releases leadership of the session
+func (e *LeaderElection) Resign(ctx context.Context) error {
+	e.mutex.Lock()
+	lease := e.lease
+	e.lease = nil
+	e.mutex.Unlock()
+
+	if lease == nil || e.session.Context().Err() != nil {
+		return nil
+	}
+
+	return xerrors.WithStackTrace(lease.Release(ctx))
+}
+
+// Leader returns current leader, nil if there is no leader
+func (e *LeaderElection) Leader(ctx context.Context) (*Leader, error) {
+	description, err := e.session.DescribeSemaphore(ctx, e.name, options.WithDescribeOwners(true))
+	if err != nil {
+		if xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return leaderFromDescription(description), nil
+}
+
+// Observe returns channel with current leader and leader changes, nil value means there is no leader.
+// Channel closed after ctx cancelled or session closed.
+func (e *LeaderElection) Observe(ctx context.Context) (<-chan *Leader, error) {
+	if err := createSemaphoreIfNotExists(ctx, e.session, e.name, 1); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	descriptions, err := e.session.WatchSemaphore(ctx, e.name, options.WithDescribeOwners(true))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	description, ok := <-descriptions
+	if !ok {
+		if err = ctx.Err(); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return nil, xerrors.WithStackTrace(ErrSessionClosed)
+	}
+
+	leaders := make(chan *Leader, 1)
+	leader := leaderFromDescription(description)
+	leaders <- leader
+
+	go func() {
+		defer close(leaders)
+
+		for description := range descriptions {
+			next := leaderFromDescription(description)
+			if next.equal(leader) {
+				continue
+			}
+			leader = next
+
+			select {
+			case <-ctx.Done():
+				return
+			case leaders <- leader:
+			}
+		}
+	}()
+
+	return leaders, nil
+}
+
+func leaderFromDescription(description *SemaphoreDescription) *Leader {
+	if len(description.Owners) == 0 {
+		return nil
+	}
+
+	return &Leader{
+		SessionID: description.Owners[0].SessionID,
+		Payload:   description.Owners[0].Data,
+	}
+}
+
+func (l *Leader) equal(other *Leader) bool {
+	if l == nil || other == nil {
+		return l == other
+	}
+
+	return l.SessionID == other.SessionID && bytes.Equal(l.Payload, other.Payload)
+}
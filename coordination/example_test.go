@@ -3,9 +3,12 @@ package coordination_test
 import (
 	"context"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3"
 	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
 )
 
 //nolint:errcheck
@@ -41,3 +44,113 @@ func Example() {
 	}
 	fmt.Printf("node description: %+v\nnode config: %+v\n", e, c)
 }
+
+//nolint:errcheck
+func Example_semaphore() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed to connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	session, err := db.Coordination().Session(ctx, "/local/test")
+	if err != nil {
+		fmt.Printf("failed to start session: %v", err)
+
+		return
+	}
+	defer session.Close(ctx)
+	// ephemeral semaphore have max limit, so acquire of max count is exclusive
+	lease, err := session.AcquireSemaphore(ctx, "lock", math.MaxUint64, options.WithEphemeral(true))
+	if err != nil {
+		fmt.Printf("failed to acquire semaphore: %v", err)
+
+		return
+	}
+	defer lease.Release(ctx)
+	// lease context cancelled if session lost, stop the work in this case
+	select {
+	case <-lease.Context().Done():
+		fmt.Println("lock lost")
+	case <-time.After(time.Second):
+		fmt.Println("work done under lock")
+	}
+}
+
+//nolint:errcheck
+func Example_mutex() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed to connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	session, err := db.Coordination().Session(ctx, "/local/test")
+	if err != nil {
+		fmt.Printf("failed to start session: %v", err)
+
+		return
+	}
+	defer session.Close(ctx)
+	mutex := coordination.NewMutex(session, "lock")
+	lockCtx, err := mutex.Lock(ctx)
+	if err != nil {
+		fmt.Printf("failed to lock: %v", err)
+
+		return
+	}
+	defer mutex.Unlock(ctx)
+	// lock context cancelled if lock lost, stop the work in this case
+	select {
+	case <-lockCtx.Done():
+		fmt.Println("lock lost")
+	case <-time.After(time.Second):
+		fmt.Println("work done under lock")
+	}
+}
+
+//nolint:errcheck
+func Example_leaderElection() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed to connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	session, err := db.Coordination().Session(ctx, "/local/test")
+	if err != nil {
+		fmt.Printf("failed to start session: %v", err)
+
+		return
+	}
+	defer session.Close(ctx)
+	election := coordination.NewLeaderElection(session, "leader", []byte("host-1:8080"))
+	leaders, err := election.Observe(ctx)
+	if err != nil {
+		fmt.Printf("failed to observe: %v", err)
+
+		return
+	}
+	go func() {
+		for leader := range leaders {
+			if leader != nil {
+				fmt.Printf("leader is %s\n", leader.Payload)
+			}
+		}
+	}()
+	leaderCtx, err := election.Campaign(ctx)
+	if err != nil {
+		fmt.Printf("failed to campaign: %v", err)
+
+		return
+	}
+	defer election.Resign(ctx)
+	// work as leader until leadership lost
+	<-leaderCtx.Done()
+}
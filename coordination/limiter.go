@@ -0,0 +1,67 @@
+package coordination
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Limiter is distributed counting semaphore, which limits total count of tokens acquired by all sessions
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Limiter struct {
+	session Session
+	name    string
+	limit   uint64
+}
+
+// NewLimiter makes limiter over persistent semaphore with name and limit.
+// Semaphore created on first Acquire if it not exists, limit of existing semaphore is not changed.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func NewLimiter(session Session, name string, limit uint64) *Limiter {
+	return &Limiter{
+		session: session,
+		name:    name,
+		limit:   limit,
+	}
+}
+
+// Acquire waits for count of tokens until ctx done.
+// Tokens of the session are held by single lease, repeated Acquire changes count of tokens of the session.
+// Lease.Release returns all tokens of the session.
+func (l *Limiter) Acquire(ctx context.Context, count uint64, opts ...options.AcquireSemaphoreOption) (Lease, error) {
+	if count > l.limit {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: count %d is greater than limit %d of limiter %q",
+			count, l.limit, l.name,
+		))
+	}
+
+	if err := createSemaphoreIfNotExists(ctx, l.session, l.name, l.limit); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	lease, err := l.session.AcquireSemaphore(ctx, l.name, count, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return lease, nil
+}
+
+func createSemaphoreIfNotExists(ctx context.Context, session Session, name string, limit uint64) error {
+	err := session.CreateSemaphore(ctx, name, limit)
+	if err != nil && !xerrors.IsOperationError(err, Ydb.StatusIds_ALREADY_EXISTS) {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
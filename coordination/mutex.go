@@ -0,0 +1,95 @@
+package coordination
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ErrMutexNotLocked returned from Mutex.Unlock if mutex is not locked
+var ErrMutexNotLocked = xerrors.Wrap(errors.New("ydb: coordination mutex is not locked"))
+
+// Mutex is distributed mutex over ephemeral semaphore of coordination node.
+//
+// Mutex excludes sessions from each other and goroutines which use same Mutex object.
+// Different Mutex objects with same name in one session are not excluded from each other,
+// because semaphore owner is a session.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Mutex struct {
+	session Session
+	name    string
+
+	// locked is local lock of Mutex object
+	locked chan struct{}
+
+	mutex sync.Mutex
+	lease Lease
+}
+
+// NewMutex makes distributed mutex with name over session
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func NewMutex(session Session, name string) *Mutex {
+	return &Mutex{
+		session: session,
+		name:    name,
+		locked:  make(chan struct{}, 1),
+	}
+}
+
+// Lock waits for the mutex until ctx done.
+// Lock returns context which cancelled when lock is lost: after Unlock, session close or session lost.
+// Unlock must be called after lost lock too.
+func (m *Mutex) Lock(ctx context.Context) (context.Context, error) {
+	select {
+	case <-ctx.Done():
+		return nil, xerrors.WithStackTrace(ctx.Err())
+	case m.locked <- struct{}{}:
+	}
+
+	// ephemeral semaphore have max limit, so max count makes it exclusive
+	lease, err := m.session.AcquireSemaphore(ctx, m.name, math.MaxUint64, options.WithEphemeral(true))
+	if err != nil {
+		<-m.locked
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	m.mutex.Lock()
+	m.lease = lease
+	m.mutex.Unlock()
+
+	return lease.Context(), nil
+}
+
+// Unlock releases the mutex
+func (m *Mutex) Unlock(ctx context.Context) error {
+	m.mutex.Lock()
+	lease := m.lease
+	m.lease = nil
+	m.mutex.Unlock()
+
+	if lease == nil {
+		return xerrors.WithStackTrace(ErrMutexNotLocked)
+	}
+
+	defer func() {
+		<-m.locked
+	}()
+
+	if lease.Context().Err() != nil && m.session.Context().Err() != nil {
+		// lock lost with session, nothing to release
+		return nil
+	}
+
+	return xerrors.WithStackTrace(lease.Release(ctx))
+}
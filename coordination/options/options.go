@@ -0,0 +1,173 @@
+package options
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+)
+
+const (
+	defaultSessionTimeout        = 5 * time.Second
+	defaultSessionStartTimeout   = time.Second
+	defaultSessionStopTimeout    = time.Second
+	defaultSessionReconnectDelay = 500 * time.Millisecond
+)
+
+// CreateSessionOptions is settings of coordination session
+type CreateSessionOptions struct {
+	Description string
+
+	// SessionTimeout is timeout of session on server side. Session is lost if it can't
+	// reconnect to server during the timeout.
+	SessionTimeout time.Duration
+
+	// SessionStartTimeout is timeout of start or reattach of session on new stream
+	SessionStartTimeout time.Duration
+
+	// SessionStopTimeout is timeout of graceful stop of session on Close
+	SessionStopTimeout time.Duration
+
+	// SessionKeepAliveTimeout is timeout of responses from server, stream reconnects after the timeout
+	SessionKeepAliveTimeout time.Duration
+
+	// SessionReconnectDelay is delay between reconnect attempts
+	SessionReconnectDelay time.Duration
+}
+
+// SessionOption is option for coordination.Client.Session
+type SessionOption func(c *CreateSessionOptions)
+
+// WithDescription set user-defined description of session
+func WithDescription(description string) SessionOption {
+	return func(c *CreateSessionOptions) {
+		c.Description = description
+	}
+}
+
+// WithSessionTimeout set session timeout, default 5s
+func WithSessionTimeout(timeout time.Duration) SessionOption {
+	return func(c *CreateSessionOptions) {
+		c.SessionTimeout = timeout
+	}
+}
+
+// WithSessionStartTimeout set timeout of start or reattach of session, default 1s
+func WithSessionStartTimeout(timeout time.Duration) SessionOption {
+	return func(c *CreateSessionOptions) {
+		c.SessionStartTimeout = timeout
+	}
+}
+
+// WithSessionStopTimeout set timeout of graceful stop of session, default 1s
+func WithSessionStopTimeout(timeout time.Duration) SessionOption {
+	return func(c *CreateSessionOptions) {
+		c.SessionStopTimeout = timeout
+	}
+}
+
+// WithSessionKeepAliveTimeout set timeout of server responses, default is half of session timeout
+func WithSessionKeepAliveTimeout(timeout time.Duration) SessionOption {
+	return func(c *CreateSessionOptions) {
+		c.SessionKeepAliveTimeout = timeout
+	}
+}
+
+// WithSessionReconnectDelay set delay between reconnect attempts, default 500ms
+func WithSessionReconnectDelay(delay time.Duration) SessionOption {
+	return func(c *CreateSessionOptions) {
+		c.SessionReconnectDelay = delay
+	}
+}
+
+// NewCreateSessionOptions applies options over defaults
+func NewCreateSessionOptions(opts ...SessionOption) *CreateSessionOptions {
+	c := &CreateSessionOptions{
+		SessionTimeout:        defaultSessionTimeout,
+		SessionStartTimeout:   defaultSessionStartTimeout,
+		SessionStopTimeout:    defaultSessionStopTimeout,
+		SessionReconnectDelay: defaultSessionReconnectDelay,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+	if c.SessionKeepAliveTimeout <= 0 {
+		c.SessionKeepAliveTimeout = c.SessionTimeout / 2 //nolint:gomnd
+	}
+
+	return c
+}
+
+// CreateSemaphoreOption is option for coordination.Session.CreateSemaphore
+type CreateSemaphoreOption func(r *Ydb_Coordination.SessionRequest_CreateSemaphore)
+
+// WithCreateData set initial data of semaphore
+func WithCreateData(data []byte) CreateSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_CreateSemaphore) {
+		r.Data = data
+	}
+}
+
+// UpdateSemaphoreOption is option for coordination.Session.UpdateSemaphore
+type UpdateSemaphoreOption func(r *Ydb_Coordination.SessionRequest_UpdateSemaphore)
+
+// WithUpdateData set new data of semaphore
+func WithUpdateData(data []byte) UpdateSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_UpdateSemaphore) {
+		r.Data = data
+	}
+}
+
+// DeleteSemaphoreOption is option for coordination.Session.DeleteSemaphore
+type DeleteSemaphoreOption func(r *Ydb_Coordination.SessionRequest_DeleteSemaphore)
+
+// WithForceDelete allow delete semaphore with owners or waiters
+func WithForceDelete(force bool) DeleteSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_DeleteSemaphore) {
+		r.Force = force
+	}
+}
+
+// DescribeSemaphoreOption is option for coordination.Session.DescribeSemaphore and
+// coordination.Session.WatchSemaphore
+type DescribeSemaphoreOption func(r *Ydb_Coordination.SessionRequest_DescribeSemaphore)
+
+// WithDescribeOwners include owners to semaphore description
+func WithDescribeOwners(describeOwners bool) DescribeSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_DescribeSemaphore) {
+		r.IncludeOwners = describeOwners
+	}
+}
+
+// WithDescribeWaiters include waiters to semaphore description
+func WithDescribeWaiters(describeWaiters bool) DescribeSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_DescribeSemaphore) {
+		r.IncludeWaiters = describeWaiters
+	}
+}
+
+// AcquireSemaphoreOption is option for coordination.Session.AcquireSemaphore
+type AcquireSemaphoreOption func(r *Ydb_Coordination.SessionRequest_AcquireSemaphore)
+
+// WithEphemeral acquire ephemeral semaphore, which created on first acquire and deleted after last release
+func WithEphemeral(ephemeral bool) AcquireSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_AcquireSemaphore) {
+		r.Ephemeral = ephemeral
+	}
+}
+
+// WithAcquireTimeout set timeout of waiting in queue of semaphore.
+// Zero timeout means try to acquire without waiting, default is infinite waiting.
+func WithAcquireTimeout(timeout time.Duration) AcquireSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_AcquireSemaphore) {
+		r.TimeoutMillis = uint64(timeout.Milliseconds())
+	}
+}
+
+// WithAcquireData set data of the owner, data visible in semaphore description
+func WithAcquireData(data []byte) AcquireSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_AcquireSemaphore) {
+		r.Data = data
+	}
+}
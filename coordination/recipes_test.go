@@ -0,0 +1,255 @@
+package coordination_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	internalCoordination "github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/coordinationtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func newTestClient(server *coordinationtest.Server) coordination.Client {
+	client, _ := internalCoordination.New(context.Background(), server, config.New())
+
+	return client
+}
+
+func TestMutex(t *testing.T) {
+	ctx := xtest.Context(t)
+	server := coordinationtest.NewServer()
+	client := newTestClient(server)
+
+	s1, err := client.Session(ctx, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	s2, err := client.Session(ctx, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	defer func() {
+		_ = s2.Close(ctx)
+	}()
+
+	m1 := coordination.NewMutex(s1, "mutex")
+	m2 := coordination.NewMutex(s2, "mutex")
+
+	require.ErrorIs(t, m1.Unlock(ctx), coordination.ErrMutexNotLocked)
+
+	lockCtx, err := m1.Lock(ctx)
+	require.NoError(t, err)
+
+	locked := make(chan context.Context, 1)
+	go func() {
+		lockCtx, err := m2.Lock(ctx)
+		if err == nil {
+			locked <- lockCtx
+		}
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("mutex locked twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(t, m1.Unlock(ctx))
+	require.Error(t, lockCtx.Err())
+
+	lockCtx = <-locked
+	require.NotNil(t, lockCtx)
+	require.NoError(t, lockCtx.Err())
+
+	// lock lost after close of session
+	require.NoError(t, s2.Close(ctx))
+	<-lockCtx.Done()
+	require.NoError(t, m2.Unlock(ctx))
+
+	lockCtx, err = m1.Lock(ctx)
+	require.NoError(t, err)
+	require.NoError(t, lockCtx.Err())
+	require.NoError(t, m1.Unlock(ctx))
+	require.NoError(t, s1.Close(ctx))
+}
+
+func TestMutexLockCancel(t *testing.T) {
+	ctx := xtest.Context(t)
+	server := coordinationtest.NewServer()
+	client := newTestClient(server)
+
+	s1, err := client.Session(ctx, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	defer func() {
+		_ = s1.Close(ctx)
+	}()
+	s2, err := client.Session(ctx, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	defer func() {
+		_ = s2.Close(ctx)
+	}()
+
+	m1 := coordination.NewMutex(s1, "mutex")
+	m2 := coordination.NewMutex(s2, "mutex")
+
+	_, err = m1.Lock(ctx)
+	require.NoError(t, err)
+
+	lockCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = m2.Lock(lockCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorIs(t, m2.Unlock(ctx), coordination.ErrMutexNotLocked)
+
+	require.NoError(t, m1.Unlock(ctx))
+	_, err = m2.Lock(ctx)
+	require.NoError(t, err)
+	require.NoError(t, m2.Unlock(ctx))
+}
+
+func TestLeaderElection(t *testing.T) {
+	ctx := xtest.Context(t)
+	server := coordinationtest.NewServer()
+	client := newTestClient(server)
+
+	s1, err := client.Session(ctx, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	s2, err := client.Session(ctx, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	defer func() {
+		_ = s2.Close(ctx)
+	}()
+
+	e1 := coordination.NewLeaderElection(s1, "election", []byte("first"))
+	e2 := coordination.NewLeaderElection(s2, "election", []byte("second"))
+
+	leader, err := e1.Leader(ctx)
+	require.NoError(t, err)
+	require.Nil(t, leader)
+
+	observeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	leaders, err := e2.Observe(observeCtx)
+	require.NoError(t, err)
+	require.Nil(t, <-leaders)
+
+	leaderCtx, err := e1.Campaign(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &coordination.Leader{SessionID: s1.SessionID(), Payload: []byte("first")}, <-leaders)
+
+	leader, err = e2.Leader(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []byte("first"), leader.Payload)
+
+	campaign := make(chan context.Context, 1)
+	go func() {
+		leaderCtx, err := e2.Campaign(ctx)
+		if err == nil {
+			campaign <- leaderCtx
+		}
+		close(campaign)
+	}()
+
+	// leadership passed to second candidate after close of leader session
+	require.NoError(t, s1.Close(ctx))
+	<-leaderCtx.Done()
+
+	leaderCtx = <-campaign
+	require.NotNil(t, leaderCtx)
+	require.NoError(t, leaderCtx.Err())
+	for leader = range leaders {
+		if leader != nil {
+			break
+		}
+	}
+	require.Equal(t, &coordination.Leader{SessionID: s2.SessionID(), Payload: []byte("second")}, leader)
+
+	require.NoError(t, e2.Resign(ctx))
+	require.Error(t, leaderCtx.Err())
+	require.Nil(t, <-leaders)
+
+	cancel()
+	for range leaders {
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	ctx := xtest.Context(t)
+	server := coordinationtest.NewServer()
+	client := newTestClient(server)
+
+	s1, err := client.Session(ctx, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	defer func() {
+		_ = s1.Close(ctx)
+	}()
+	s2, err := client.Session(ctx, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	defer func() {
+		_ = s2.Close(ctx)
+	}()
+
+	l1 := coordination.NewLimiter(s1, "limiter", 3)
+	l2 := coordination.NewLimiter(s2, "limiter", 3)
+
+	_, err = l1.Acquire(ctx, 4)
+	require.Error(t, err)
+
+	lease1, err := l1.Acquire(ctx, 2)
+	require.NoError(t, err)
+	lease2, err := l2.Acquire(ctx, 1)
+	require.NoError(t, err)
+
+	acquired := make(chan struct{})
+	go func() {
+		if _, err := l2.Acquire(ctx, 2); err == nil {
+			close(acquired)
+		}
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("limit exceeded")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(t, lease1.Release(ctx))
+	<-acquired
+
+	description, err := s1.DescribeSemaphore(ctx, "limiter")
+	require.NoError(t, err)
+	require.EqualValues(t, 3, description.Limit)
+	require.EqualValues(t, 2, description.Count)
+
+	require.NoError(t, lease2.Release(ctx))
+}
+
+// closedWatchSession is session, which closed after create of semaphore watch
+type closedWatchSession struct {
+	coordination.Session
+}
+
+func (s closedWatchSession) CreateSemaphore(
+	ctx context.Context, name string, limit uint64, opts ...options.CreateSemaphoreOption,
+) error {
+	return nil
+}
+
+func (s closedWatchSession) WatchSemaphore(
+	ctx context.Context, name string, opts ...options.DescribeSemaphoreOption,
+) (<-chan *coordination.SemaphoreDescription, error) {
+	descriptions := make(chan *coordination.SemaphoreDescription)
+	close(descriptions)
+
+	return descriptions, nil
+}
+
+func TestLeaderElectionObserveClosedSession(t *testing.T) {
+	e := coordination.NewLeaderElection(closedWatchSession{}, "election", nil)
+
+	_, err := e.Observe(xtest.Context(t))
+	require.ErrorIs(t, err, coordination.ErrSessionClosed)
+}
@@ -0,0 +1,154 @@
+package registry
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	versionSize = 8
+
+	// releaseTimeout limits release of config semaphore, which not depends on context of Publish
+	releaseTimeout = 10 * time.Second
+)
+
+var errInvalidConfigData = xerrors.Wrap(errors.New("ydb: invalid data of config semaphore"))
+
+// Config is published config
+type Config struct {
+	Key string
+
+	// Version is incremented on each publish, zero version means config not published yet
+	Version uint64
+
+	Payload []byte
+}
+
+// Publish publishes new payload of config with key and returns new version of config.
+// Concurrent publishes are excluded by config semaphore.
+func (r *Registry) Publish(ctx context.Context, key string, payload []byte) (version uint64, _ error) {
+	r.publishMutex.Lock()
+	defer r.publishMutex.Unlock()
+
+	if err := r.createSemaphore(ctx, configPrefix+key, 1); err != nil {
+		return 0, xerrors.WithStackTrace(err)
+	}
+
+	lease, err := r.session.AcquireSemaphore(ctx, configPrefix+key, 1)
+	if err != nil {
+		return 0, xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		// context of Publish may be already cancelled, but semaphore must be released anyway
+		releaseCtx, cancel := xcontext.WithTimeout(xcontext.WithoutDeadline(ctx), releaseTimeout)
+		defer cancel()
+
+		_ = lease.Release(releaseCtx)
+	}()
+
+	description, err := r.session.DescribeSemaphore(ctx, configPrefix+key)
+	if err != nil {
+		return 0, xerrors.WithStackTrace(err)
+	}
+
+	config, err := configFromDescription(key, description)
+	if err != nil {
+		return 0, xerrors.WithStackTrace(err)
+	}
+
+	version = config.Version + 1
+	data := make([]byte, versionSize+len(payload))
+	binary.BigEndian.PutUint64(data, version)
+	copy(data[versionSize:], payload)
+
+	if err = r.session.UpdateSemaphore(ctx, configPrefix+key, options.WithUpdateData(data)); err != nil {
+		return 0, xerrors.WithStackTrace(err)
+	}
+
+	return version, nil
+}
+
+// Config returns current config with key, config with zero version returned if config not published yet
+func (r *Registry) Config(ctx context.Context, key string) (*Config, error) {
+	description, err := r.session.DescribeSemaphore(ctx, configPrefix+key)
+	if err != nil {
+		if xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND) {
+			return &Config{Key: key}, nil
+		}
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return configFromDescription(key, description)
+}
+
+// WatchConfig returns channel with current config with key and next published versions of the config.
+// Channel closed after ctx cancelled or session closed.
+func (r *Registry) WatchConfig(ctx context.Context, key string) (<-chan *Config, error) {
+	if err := r.createSemaphore(ctx, configPrefix+key, 1); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	descriptions, err := r.session.WatchSemaphore(ctx, configPrefix+key)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	description, ok := <-descriptions
+	if !ok {
+		return nil, xerrors.WithStackTrace(r.watchClosedErr(ctx))
+	}
+
+	current, err := configFromDescription(key, description)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	configs := make(chan *Config, 1)
+	configs <- current
+
+	go func() {
+		defer close(configs)
+
+		for description := range descriptions {
+			next, err := configFromDescription(key, description)
+			if err != nil || next.Version <= current.Version {
+				continue
+			}
+			current = next
+
+			select {
+			case <-ctx.Done():
+				return
+			case configs <- current:
+			}
+		}
+	}()
+
+	return configs, nil
+}
+
+func configFromDescription(key string, description *coordination.SemaphoreDescription) (*Config, error) {
+	if len(description.Data) == 0 {
+		return &Config{Key: key}, nil
+	}
+	if len(description.Data) < versionSize {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errInvalidConfigData, key))
+	}
+
+	return &Config{
+		Key:     key,
+		Version: binary.BigEndian.Uint64(description.Data),
+		Payload: description.Data[versionSize:],
+	}, nil
+}
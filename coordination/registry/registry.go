@@ -0,0 +1,87 @@
+// Package registry provides service discovery and configuration publishing over coordination node.
+//
+// Instances of service are owners of ephemeral service semaphore with metadata as owner data, ownership
+// released after session close or session lost, semaphore deleted after leave of last instance. Configs are data of config semaphores with version.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+package registry
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	servicePrefix = "service/"
+	configPrefix  = "config/"
+)
+
+// Registry is service registry and config store over coordination session
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Registry struct {
+	session coordination.Session
+
+	// publishMutex excludes concurrent publishes of the registry, because
+	// semaphore owner is a session
+	publishMutex sync.Mutex
+}
+
+// Open starts coordination session of node with path and makes registry over it
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func Open(
+	ctx context.Context,
+	client coordination.Client,
+	path string,
+	opts ...options.SessionOption,
+) (*Registry, error) {
+	session, err := client.Session(ctx, path, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return New(session), nil
+}
+
+// New makes registry over existing coordination session
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func New(session coordination.Session) *Registry {
+	return &Registry{
+		session: session,
+	}
+}
+
+// Session returns coordination session of the registry
+func (r *Registry) Session() coordination.Session {
+	return r.session
+}
+
+// Close closes session of the registry, all registrations of the session are released
+func (r *Registry) Close(ctx context.Context) error {
+	return xerrors.WithStackTrace(r.session.Close(ctx))
+}
+
+func (r *Registry) createSemaphore(ctx context.Context, name string, limit uint64) error {
+	err := r.session.CreateSemaphore(ctx, name, limit)
+	if err != nil && !xerrors.IsOperationError(err, Ydb.StatusIds_ALREADY_EXISTS) {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
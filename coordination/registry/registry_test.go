@@ -0,0 +1,202 @@
+package registry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	internalCoordination "github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/coordinationtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func newTestClient(server *coordinationtest.Server) coordination.Client {
+	client, _ := internalCoordination.New(context.Background(), server, config.New())
+
+	return client
+}
+
+func TestRegistryInstances(t *testing.T) {
+	ctx := xtest.Context(t)
+	server := coordinationtest.NewServer()
+	client := newTestClient(server)
+
+	r1, err := Open(ctx, client, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	r2, err := Open(ctx, client, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	defer func() {
+		_ = r2.Close(ctx)
+	}()
+
+	instances, err := r1.Instances(ctx, "api")
+	require.NoError(t, err)
+	require.Empty(t, instances)
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	changes, err := r2.Watch(watchCtx, "api")
+	require.NoError(t, err)
+	require.Empty(t, <-changes)
+
+	_, err = r1.Register(ctx, "api", []byte("host-1"))
+	require.NoError(t, err)
+	require.Equal(t, []Instance{
+		{SessionID: r1.Session().SessionID(), Metadata: []byte("host-1")},
+	}, <-changes)
+
+	lease, err := r2.Register(ctx, "api", []byte("host-2"))
+	require.NoError(t, err)
+	require.Equal(t, []Instance{
+		{SessionID: r1.Session().SessionID(), Metadata: []byte("host-1")},
+		{SessionID: r2.Session().SessionID(), Metadata: []byte("host-2")},
+	}, <-changes)
+
+	instances, err = r1.Instances(ctx, "api")
+	require.NoError(t, err)
+	require.Len(t, instances, 2)
+
+	// instance unregistered after close of session
+	require.NoError(t, r1.Close(ctx))
+	require.Equal(t, []Instance{
+		{SessionID: r2.Session().SessionID(), Metadata: []byte("host-2")},
+	}, <-changes)
+
+	require.NoError(t, lease.Release(ctx))
+	require.Empty(t, <-changes)
+
+	// service semaphore is ephemeral, nothing left in the node after leave of last instance
+	_, err = r2.Session().DescribeSemaphore(ctx, servicePrefix+"api")
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND))
+
+	cancel()
+	for range changes {
+	}
+}
+
+func TestRegistryConfig(t *testing.T) {
+	ctx := xtest.Context(t)
+	server := coordinationtest.NewServer()
+	client := newTestClient(server)
+
+	r1, err := Open(ctx, client, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	defer func() {
+		_ = r1.Close(ctx)
+	}()
+	r2, err := Open(ctx, client, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	defer func() {
+		_ = r2.Close(ctx)
+	}()
+
+	config, err := r2.Config(ctx, "settings")
+	require.NoError(t, err)
+	require.Equal(t, &Config{Key: "settings"}, config)
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	configs, err := r2.WatchConfig(watchCtx, "settings")
+	require.NoError(t, err)
+	require.Zero(t, (<-configs).Version)
+
+	version, err := r1.Publish(ctx, "settings", []byte("v1"))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, version)
+	require.Equal(t, &Config{Key: "settings", Version: 1, Payload: []byte("v1")}, <-configs)
+
+	version, err = r2.Publish(ctx, "settings", []byte("v2"))
+	require.NoError(t, err)
+	require.EqualValues(t, 2, version)
+	require.Equal(t, &Config{Key: "settings", Version: 2, Payload: []byte("v2")}, <-configs)
+
+	config, err = r1.Config(ctx, "settings")
+	require.NoError(t, err)
+	require.Equal(t, &Config{Key: "settings", Version: 2, Payload: []byte("v2")}, config)
+
+	cancel()
+	for range configs {
+	}
+}
+
+// cancelOnDescribeSession cancels context of call on describe of semaphore
+type cancelOnDescribeSession struct {
+	coordination.Session
+
+	cancel context.CancelFunc
+}
+
+func (s cancelOnDescribeSession) DescribeSemaphore(
+	ctx context.Context, name string, opts ...options.DescribeSemaphoreOption,
+) (*coordination.SemaphoreDescription, error) {
+	s.cancel()
+
+	return nil, ctx.Err()
+}
+
+func TestRegistryPublishCancelled(t *testing.T) {
+	ctx := xtest.Context(t)
+	server := coordinationtest.NewServer()
+	client := newTestClient(server)
+
+	session, err := client.Session(ctx, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	defer func() {
+		_ = session.Close(ctx)
+	}()
+
+	publishCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	_, err = New(cancelOnDescribeSession{Session: session, cancel: cancel}).Publish(publishCtx, "settings", nil)
+	require.ErrorIs(t, err, context.Canceled)
+
+	// config semaphore released by cancelled publish
+	r, err := Open(ctx, client, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	defer func() {
+		_ = r.Close(ctx)
+	}()
+	timeoutCtx, timeoutCancel := context.WithTimeout(ctx, time.Second)
+	defer timeoutCancel()
+	version, err := r.Publish(timeoutCtx, "settings", []byte("v1"))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, version)
+}
+
+// closedWatchSession is session, which closed after create of semaphore watch
+type closedWatchSession struct {
+	coordination.Session
+}
+
+func (s closedWatchSession) CreateSemaphore(
+	ctx context.Context, name string, limit uint64, opts ...options.CreateSemaphoreOption,
+) error {
+	return nil
+}
+
+func (s closedWatchSession) WatchSemaphore(
+	ctx context.Context, name string, opts ...options.DescribeSemaphoreOption,
+) (<-chan *coordination.SemaphoreDescription, error) {
+	descriptions := make(chan *coordination.SemaphoreDescription)
+	close(descriptions)
+
+	return descriptions, nil
+}
+
+func TestRegistryWatchClosedSession(t *testing.T) {
+	ctx := xtest.Context(t)
+	r := New(closedWatchSession{})
+
+	_, err := r.Watch(ctx, "api")
+	require.ErrorIs(t, err, coordination.ErrSessionClosed)
+
+	_, err = r.WatchConfig(ctx, "settings")
+	require.ErrorIs(t, err, coordination.ErrSessionClosed)
+}
@@ -0,0 +1,187 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// watchServicePollInterval is interval of checks of service without instances
+const watchServicePollInterval = time.Second
+
+// Instance is registered instance of service
+type Instance struct {
+	// SessionID is ID of coordination session of the instance
+	SessionID uint64
+
+	// Metadata is user-defined data of the instance, for example address of the instance
+	Metadata []byte
+}
+
+// Register registers the session as instance of service with metadata.
+// Instance is registered until lease released or session closed or lost.
+// Session is single instance of service, repeated Register changes metadata of the instance.
+func (r *Registry) Register(ctx context.Context, service string, metadata []byte) (coordination.Lease, error) {
+	// service semaphore is ephemeral: it created by first instance and deleted with last instance,
+	// so nothing is left in the node after instances leave
+	lease, err := r.session.AcquireSemaphore(ctx, servicePrefix+service, 1,
+		options.WithEphemeral(true),
+		options.WithAcquireData(metadata),
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return lease, nil
+}
+
+// Instances returns registered instances of service ordered by session ID
+func (r *Registry) Instances(ctx context.Context, service string) ([]Instance, error) {
+	description, err := r.session.DescribeSemaphore(ctx, servicePrefix+service,
+		options.WithDescribeOwners(true),
+	)
+	if err != nil {
+		if xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND) {
+			return nil, nil
+		}
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return instancesFromDescription(description), nil
+}
+
+// Watch returns channel with current instances of service and changes of instances.
+// Service without instances has no semaphore, so appearance of first instance is observed with
+// polling interval of one second.
+// Channel closed after ctx cancelled or session closed.
+func (r *Registry) Watch(ctx context.Context, service string) (<-chan []Instance, error) {
+	descriptions, err := r.watchService(ctx, service)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	instances := make(chan []Instance, 1)
+	current := []Instance{}
+	if descriptions != nil {
+		description, ok := <-descriptions
+		if !ok {
+			return nil, xerrors.WithStackTrace(r.watchClosedErr(ctx))
+		}
+		current = instancesFromDescription(description)
+	}
+	instances <- current
+
+	go func() {
+		defer close(instances)
+
+		for {
+			if descriptions == nil {
+				// no instances, wait for first instance
+				select {
+				case <-ctx.Done():
+					return
+				case <-r.session.Context().Done():
+					return
+				case <-time.After(watchServicePollInterval):
+				}
+			} else {
+				for description := range descriptions {
+					next := instancesFromDescription(description)
+					if instancesEqual(current, next) {
+						continue
+					}
+					current = next
+
+					select {
+					case <-ctx.Done():
+						return
+					case instances <- current:
+					}
+				}
+
+				if ctx.Err() != nil || r.session.Context().Err() != nil {
+					return
+				}
+			}
+
+			// semaphore deleted after leave of last instance or not created yet
+			var err error
+			descriptions, err = r.watchService(ctx, service)
+			if err != nil {
+				return
+			}
+			if descriptions == nil && len(current) > 0 {
+				current = []Instance{}
+
+				select {
+				case <-ctx.Done():
+					return
+				case instances <- current:
+				}
+			}
+		}
+	}()
+
+	return instances, nil
+}
+
+// watchService starts watch of service semaphore, nil channel means service has no instances
+func (r *Registry) watchService(ctx context.Context, service string) (<-chan *coordination.SemaphoreDescription, error) {
+	descriptions, err := r.session.WatchSemaphore(ctx, servicePrefix+service,
+		options.WithDescribeOwners(true),
+	)
+	if err != nil {
+		if xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND) {
+			return nil, nil
+		}
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return descriptions, nil
+}
+
+// watchClosedErr returns reason of close of watch channel
+func (r *Registry) watchClosedErr(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return coordination.ErrSessionClosed
+}
+
+func instancesFromDescription(description *coordination.SemaphoreDescription) []Instance {
+	instances := make([]Instance, 0, len(description.Owners))
+	for _, owner := range description.Owners {
+		instances = append(instances, Instance{
+			SessionID: owner.SessionID,
+			Metadata:  owner.Data,
+		})
+	}
+	sort.Slice(instances, func(i, j int) bool {
+		return instances[i].SessionID < instances[j].SessionID
+	})
+
+	return instances
+}
+
+func instancesEqual(lhs, rhs []Instance) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if lhs[i].SessionID != rhs[i].SessionID || !bytes.Equal(lhs[i].Metadata, rhs[i].Metadata) {
+			return false
+		}
+	}
+
+	return true
+}
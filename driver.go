@@ -3,6 +3,7 @@ package ydb
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"sync"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/credentials"
 	internalDiscovery "github.com/ydb-platform/ydb-go-sdk/v3/internal/discovery"
 	discoveryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/discovery/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/drain"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/dsn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
@@ -132,6 +134,11 @@ func (d *Driver) Close(ctx context.Context) (finalErr error) {
 		}
 	}()
 
+	var issues []error
+	if err := d.drain(ctx); err != nil {
+		issues = append(issues, err)
+	}
+
 	closes := make([]func(context.Context) error, 0)
 	d.childrenMtx.WithLock(func() {
 		for _, child := range d.children {
@@ -153,7 +160,6 @@ func (d *Driver) Close(ctx context.Context) (finalErr error) {
 		d.pool.Release,
 	)
 
-	var issues []error
 	for _, f := range closes {
 		if err := f(ctx); err != nil {
 			issues = append(issues, err)
@@ -167,6 +173,72 @@ func (d *Driver) Close(ctx context.Context) (finalErr error) {
 	return nil
 }
 
+// drain rejects new calls of clients and waits for in-flight calls, transactions, topic readers
+// and writers before close of clients
+func (d *Driver) drain(ctx context.Context) (finalErr error) {
+	if d.config == nil || d.config.DrainTimeout() <= 0 {
+		return nil
+	}
+
+	timeout := d.config.DrainTimeout()
+
+	ctx, cancel := xcontext.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	drainers := make(map[string]drain.Drainer, 3)
+	if d.table != nil {
+		drainers["table"] = d.table
+	}
+	if d.query != nil {
+		drainers["query"] = d.query
+	}
+	if d.topic != nil {
+		drainers["topic"] = d.topic
+	}
+	inflight := func() (inflight int) {
+		for _, drainer := range drainers {
+			inflight += drainer.Inflight()
+		}
+
+		return inflight
+	}
+
+	onDone := trace.DriverOnDrain(d.trace(), &ctx, stack.FunctionID(""), timeout, inflight())
+	defer func() {
+		onDone(inflight(), finalErr)
+	}()
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		issues []error
+	)
+	for name, drainer := range drainers {
+		wg.Add(1)
+		go func(ctx context.Context, name string, drainer drain.Drainer) {
+			defer wg.Done()
+
+			onDone := trace.DriverOnDrainClient(d.trace(), &ctx, stack.FunctionID(""), name, drainer.Inflight())
+			err := drainer.Drain(ctx)
+			onDone(drainer.Inflight(), err)
+
+			if err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+
+				issues = append(issues, fmt.Errorf("drain of %s client failed: %w", name, err))
+			}
+		}(ctx, name, drainer)
+	}
+	wg.Wait()
+
+	if len(issues) > 0 {
+		return xerrors.WithStackTrace(xerrors.NewWithIssues("drain failed", issues...))
+	}
+
+	return nil
+}
+
 // Endpoint returns initial endpoint.
 func (d *Driver) Endpoint() string {
 	return d.config.Endpoint()
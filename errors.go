@@ -4,11 +4,20 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	grpcCodes "google.golang.org/grpc/codes"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/drain"
 	ratelimiterErrors "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/errors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/ratelimiter"
 )
 
+// ErrDriverClosing is returned from new calls of Do, DoTx, StartReader and StartWriter
+// on drain phase of Driver.Close
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+var ErrDriverClosing = drain.ErrClosing
+
 // IterateByIssues helps to iterate over internal issues of operation error.
 func IterateByIssues(err error, it func(message string, code Ydb.StatusIds_StatusCode, severity uint32)) {
 	xerrors.IterateByIssues(err, it)
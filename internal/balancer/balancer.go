@@ -3,6 +3,7 @@ package balancer
 import (
 	"context"
 	"fmt"
+	"net"
 	"sort"
 
 	"google.golang.org/grpc"
@@ -40,6 +41,13 @@ type Balancer struct {
 	discoveryRepeater repeater.Repeater
 	localDCDetector   func(ctx context.Context, endpoints []endpoint.Endpoint) (string, error)
 
+	// latencies is not nil for latency-aware balancer
+	latencies *latencyTracker
+	// loadFactors is not nil for balancer with weights by load factors
+	loadFactors *loadFactors
+	// outliers is not nil for balancer with outlier detection
+	outliers *outlierDetector
+
 	mu               xsync.RWMutex
 	connectionsState *connectionsState
 
@@ -191,6 +199,21 @@ func (b *Balancer) applyDiscoveredEndpoints(ctx context.Context, endpoints []end
 
 	info := balancerConfig.Info{SelfLocation: localDC}
 	state := newConnectionsState(connections, b.config.Filter, info, b.config.AllowFallback)
+	addresses := make(map[string]struct{}, len(endpoints))
+	for _, e := range endpoints {
+		addresses[e.Address()] = struct{}{}
+	}
+	if b.latencies != nil {
+		b.latencies.retain(addresses)
+		state.latencies = b.latencies
+	}
+	if b.loadFactors != nil {
+		state.weights = b.loadFactors.update(endpoints)
+	}
+	if b.outliers != nil {
+		b.outliers.retain(addresses)
+		state.outliers = b.outliers
+	}
 
 	endpointsInfo := make([]endpoint.Info, len(endpoints))
 	for i, e := range endpoints {
@@ -257,9 +280,7 @@ func New(
 		pool:            pool,
 		localDCDetector: detectLocalDC,
 	}
-	d, err := internalDiscovery.New(ctx, pool.Get(
-		endpoint.New(driverConfig.Endpoint()),
-	), discoveryConfig)
+	d, err := newDiscoveryClient(ctx, driverConfig, pool, discoveryConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -272,6 +293,16 @@ func New(
 		b.config = *config
 	}
 
+	if b.config.LeastLatency && !b.config.SingleConn {
+		b.latencies = newLatencyTracker(b.config.LatencyDecay, driverConfig.Trace())
+	}
+	if b.config.LoadFactorWeighted && !b.config.SingleConn {
+		b.loadFactors = newLoadFactors(b.config.LoadFactorDamping)
+	}
+	if b.config.OutlierDetection != nil && !b.config.SingleConn {
+		b.outliers = newOutlierDetector(*b.config.OutlierDetection, driverConfig.Trace())
+	}
+
 	if b.config.SingleConn {
 		b.applyDiscoveredEndpoints(ctx, []endpoint.Endpoint{
 			endpoint.New(driverConfig.Endpoint()),
@@ -294,6 +325,60 @@ func New(
 	return b, nil
 }
 
+// newDiscoveryClient makes source of endpoints by driver config: discovery service of YDB,
+// static endpoints or endpoints from DNS records
+func newDiscoveryClient(
+	ctx context.Context,
+	driverConfig *config.Config,
+	pool *conn.Pool,
+	discoveryConfig *discoveryConfig.Config,
+) (discoveryClient, error) {
+	d := driverConfig.Discovery()
+	if d.Type == balancerConfig.DiscoveryService {
+		return internalDiscovery.New(ctx, pool.Get(
+			endpoint.New(driverConfig.Endpoint()),
+		), discoveryConfig)
+	}
+
+	endpoints := d.Endpoints
+	if len(endpoints) == 0 {
+		endpoints = []string{driverConfig.Endpoint()}
+		if d.Type == balancerConfig.DiscoveryDNSSRV {
+			if host, _, err := net.SplitHostPort(driverConfig.Endpoint()); err == nil {
+				endpoints = []string{host}
+			}
+		}
+	}
+
+	nodes := newNodeResolver(func(ctx context.Context, address string) ([]endpoint.Endpoint, error) {
+		client, err := internalDiscovery.New(ctx, pool.Get(endpoint.New(address)), discoveryConfig)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return client.Discover(ctx)
+	})
+
+	switch d.Type {
+	case balancerConfig.DiscoveryStatic:
+		return &staticDiscovery{
+			addresses: endpoints,
+			nodes:     nodes,
+		}, nil
+	case balancerConfig.DiscoveryDNS, balancerConfig.DiscoveryDNSSRV:
+		return &dnsDiscovery{
+			hosts:    endpoints,
+			srv:      d.Type == balancerConfig.DiscoveryDNSSRV,
+			resolver: net.DefaultResolver,
+			nodes:    nodes,
+		}, nil
+	default:
+		nodes.close()
+
+		return nil, xerrors.WithStackTrace(fmt.Errorf("unknown discovery: %s", d.Type))
+	}
+}
+
 func (b *Balancer) Invoke(
 	ctx context.Context,
 	method string,
@@ -331,6 +416,22 @@ func (b *Balancer) wrapCall(ctx context.Context, f func(ctx context.Context, cc
 		return xerrors.WithStackTrace(err)
 	}
 
+	if b.latencies != nil {
+		onDone := b.latencies.start(cc)
+		defer func() {
+			// round trip time of failed call on broken endpoint is not a latency of endpoint
+			onDone(err == nil || !xerrors.MustPessimizeEndpoint(err, b.driverConfig.ExcludeGRPCCodesForPessimization()...))
+		}()
+	}
+
+	if b.outliers != nil {
+		onDone := b.outliers.start(cc)
+		defer func() {
+			// calls cancelled by client are not failures of endpoint
+			onDone(ctx.Err() == nil && isOutlierFailure(err, b.driverConfig.ExcludeGRPCCodesForPessimization()...))
+		}()
+	}
+
 	defer func() {
 		if err == nil {
 			if cc.GetState() == conn.Banned {
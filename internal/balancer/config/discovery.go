@@ -0,0 +1,62 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+type DiscoveryType int
+
+const (
+	// DiscoveryService is a default discovery of endpoints with discovery service of YDB
+	DiscoveryService = DiscoveryType(iota)
+	// DiscoveryStatic uses static list of endpoints
+	DiscoveryStatic
+	// DiscoveryDNS resolves endpoints from A/AAAA records of hosts
+	DiscoveryDNS
+	// DiscoveryDNSSRV resolves endpoints from SRV records of names
+	DiscoveryDNSSRV
+)
+
+func (t DiscoveryType) String() string {
+	switch t {
+	case DiscoveryService:
+		return "service"
+	case DiscoveryStatic:
+		return "static"
+	case DiscoveryDNS:
+		return "dns"
+	case DiscoveryDNSSRV:
+		return "srv"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(t))
+	}
+}
+
+// DiscoveryTypeFromString returns discovery type by name or false if name is unknown
+func DiscoveryTypeFromString(s string) (DiscoveryType, bool) {
+	for _, t := range []DiscoveryType{DiscoveryService, DiscoveryStatic, DiscoveryDNS, DiscoveryDNSSRV} {
+		if t.String() == s {
+			return t, true
+		}
+	}
+
+	return DiscoveryService, false
+}
+
+// Discovery defines source of endpoints for balancer
+type Discovery struct {
+	Type DiscoveryType
+
+	// Endpoints are addresses (host:port) for DiscoveryStatic, hosts with port for DiscoveryDNS
+	// or names of SRV records for DiscoveryDNSSRV. Empty Endpoints means endpoint of driver
+	Endpoints []string
+}
+
+func (d Discovery) String() string {
+	if len(d.Endpoints) == 0 {
+		return d.Type.String()
+	}
+
+	return d.Type.String() + "{" + strings.Join(d.Endpoints, ",") + "}"
+}
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// OutlierDetection is config of ejection of endpoints with many failed or slow calls
+type OutlierDetection struct {
+	// ConsecutiveFailures is count of consecutive failed calls for ejection of endpoint, zero disables check
+	ConsecutiveFailures int
+	// ErrorRate is rate of failed calls in Window for ejection of endpoint, zero disables check
+	ErrorRate float64
+	// MinRequests is minimal count of calls in Window for check of ErrorRate
+	MinRequests int
+	// Window is duration of sliding window for ErrorRate, zero means default window
+	Window time.Duration
+	// SlowCallDuration defines calls, which are counted as failed because of latency, zero disables check
+	SlowCallDuration time.Duration
+	// BaseEjectionTime is ejection time of endpoint at first ejection, ejection time doubles
+	// on each next ejection up to MaxEjectionTime. Zero means default ejection time
+	BaseEjectionTime time.Duration
+	// MaxEjectionTime is max ejection time of endpoint, zero means default max ejection time
+	MaxEjectionTime time.Duration
+	// MaxEjectedPercent is max percent of ejected endpoints of cluster, zero means default percent.
+	// One endpoint may be ejected always
+	MaxEjectedPercent int
+}
+
+func (c OutlierDetection) String() string {
+	return fmt.Sprintf(
+		"OutlierDetection{ConsecutiveFailures=%d,ErrorRate=%v,MinRequests=%d,Window=%v,SlowCallDuration=%v,"+
+			"BaseEjectionTime=%v,MaxEjectionTime=%v,MaxEjectedPercent=%d}",
+		c.ConsecutiveFailures, c.ErrorRate, c.MinRequests, c.Window, c.SlowCallDuration,
+		c.BaseEjectionTime, c.MaxEjectionTime, c.MaxEjectedPercent,
+	)
+}
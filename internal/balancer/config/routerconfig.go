@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
@@ -14,6 +15,21 @@ type Config struct {
 	AllowFallback bool
 	SingleConn    bool
 	DetectLocalDC bool
+
+	// LeastLatency enables choice of better endpoint from two random endpoints (power of two choices)
+	// by EWMA of round trip time and count of inflight calls
+	LeastLatency bool
+	// LatencyDecay is decay time of EWMA of round trip time, zero means default decay
+	LatencyDecay time.Duration
+
+	// LoadFactorWeighted enables choice of endpoints with weights by load factors of endpoints from discovery
+	LoadFactorWeighted bool
+	// LoadFactorDamping is weight of previous load factor of endpoint on each discovery round in [0, 1),
+	// zero means load factors are not smoothed
+	LoadFactorDamping float64
+
+	// OutlierDetection enables ejection of endpoints with many failed or slow calls
+	OutlierDetection *OutlierDetection
 }
 
 func (c Config) String() string {
@@ -24,7 +40,21 @@ func (c Config) String() string {
 	buffer := xstring.Buffer()
 	defer buffer.Free()
 
-	buffer.WriteString("RandomChoice{")
+	switch {
+	case c.LeastLatency:
+		buffer.WriteString("LeastLatency{")
+		if c.LatencyDecay > 0 {
+			fmt.Fprintf(buffer, "LatencyDecay=%v,", c.LatencyDecay)
+		}
+	case c.LoadFactorWeighted:
+		buffer.WriteString("LoadFactor{")
+	default:
+		buffer.WriteString("RandomChoice{")
+	}
+
+	if c.LoadFactorWeighted {
+		fmt.Fprintf(buffer, "LoadFactorDamping=%v,", c.LoadFactorDamping)
+	}
 
 	buffer.WriteString("DetectLocalDC=")
 	fmt.Fprintf(buffer, "%t", c.DetectLocalDC)
@@ -37,6 +67,11 @@ func (c Config) String() string {
 		fmt.Fprint(buffer, c.Filter.String())
 	}
 
+	if c.OutlierDetection != nil {
+		buffer.WriteByte(',')
+		buffer.WriteString(c.OutlierDetection.String())
+	}
+
 	buffer.WriteByte('}')
 
 	return buffer.String()
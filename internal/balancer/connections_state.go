@@ -16,6 +16,13 @@ type connectionsState struct {
 	all      []conn.Conn
 
 	rand xrand.Rand
+
+	// latencies is not nil for latency-aware balancer
+	latencies *latencyTracker
+	// weights is not nil for balancer with weights by load factors
+	weights map[string]float64
+	// outliers is not nil for balancer with outlier detection
+	outliers *outlierDetector
 }
 
 func newConnectionsState(
@@ -53,7 +60,7 @@ func (s *connectionsState) GetConnection(ctx context.Context) (_ conn.Conn, fail
 	}
 
 	try := func(conns []conn.Conn) conn.Conn {
-		c, tryFailed := s.selectRandomConnection(conns, false)
+		c, tryFailed := s.selectConnection(conns, false)
 		failedCount += tryFailed
 
 		return c
@@ -67,7 +74,7 @@ func (s *connectionsState) GetConnection(ctx context.Context) (_ conn.Conn, fail
 		return c, failedCount
 	}
 
-	c, _ := s.selectRandomConnection(s.all, true)
+	c, _ := s.selectConnection(s.all, true)
 
 	return c, failedCount
 }
@@ -83,6 +90,96 @@ func (s *connectionsState) preferConnection(ctx context.Context) conn.Conn {
 	return nil
 }
 
+func (s *connectionsState) selectConnection(conns []conn.Conn, allowBanned bool) (c conn.Conn, failedConns int) {
+	if s.latencies != nil {
+		return s.selectLeastLatencyConnection(conns, allowBanned)
+	}
+	if s.weights != nil {
+		return s.selectWeightedConnection(conns, allowBanned)
+	}
+
+	return s.selectRandomConnection(conns, allowBanned)
+}
+
+// selectLeastLatencyConnection chooses connection with lower score from two random connections
+// (power of two choices). If both connections are not ok - falls back to random choice
+func (s *connectionsState) selectLeastLatencyConnection(
+	conns []conn.Conn, allowBanned bool,
+) (c conn.Conn, failedConns int) {
+	connCount := len(conns)
+	if connCount < 2 {
+		return s.selectRandomConnection(conns, allowBanned)
+	}
+
+	i := s.rand.Int(connCount)
+	j := s.rand.Int(connCount - 1)
+	if j >= i {
+		j++
+	}
+
+	lhs, rhs := conns[i], conns[j]
+	switch lhsOk, rhsOk := s.isOk(lhs, allowBanned), s.isOk(rhs, allowBanned); {
+	case lhsOk && rhsOk:
+		if s.score(rhs) < s.score(lhs) {
+			return rhs, 0
+		}
+
+		return lhs, 0
+	case lhsOk:
+		return lhs, 0
+	case rhsOk:
+		return rhs, 0
+	default:
+		return s.selectRandomConnection(conns, allowBanned)
+	}
+}
+
+// score returns score of connection for latency-aware balancer, score is divided by weight
+// of connection if weights are defined
+func (s *connectionsState) score(c conn.Conn) float64 {
+	if s.weights != nil {
+		return s.latencies.score(c) / s.weight(c)
+	}
+
+	return s.latencies.score(c)
+}
+
+func (s *connectionsState) weight(c conn.Conn) float64 {
+	if w, has := s.weights[c.Endpoint().Address()]; has {
+		return w
+	}
+
+	return loadFactorWeight(0)
+}
+
+// selectWeightedConnection chooses random connection with probability proportional to weight of connection
+func (s *connectionsState) selectWeightedConnection(
+	conns []conn.Conn, allowBanned bool,
+) (c conn.Conn, failedConns int) {
+	var total float64
+	for _, c := range conns {
+		if s.isOk(c, allowBanned) {
+			total += s.weight(c)
+		}
+	}
+	if total <= 0 {
+		return s.selectRandomConnection(conns, allowBanned)
+	}
+
+	r := s.rand.Float64() * total
+	for _, cc := range conns {
+		if !s.isOk(cc, allowBanned) {
+			continue
+		}
+		c = cc
+		if r -= s.weight(cc); r < 0 {
+			break
+		}
+	}
+
+	return c, 0
+}
+
 func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned bool) (c conn.Conn, failedConns int) {
 	connCount := len(conns)
 	if connCount == 0 {
@@ -91,7 +188,7 @@ func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned
 	}
 
 	// fast path
-	if c := conns[s.rand.Int(connCount)]; isOkConnection(c, allowBanned) {
+	if c := conns[s.rand.Int(connCount)]; s.isOk(c, allowBanned) {
 		return c, 0
 	}
 
@@ -106,7 +203,7 @@ func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned
 
 	for _, index := range indexes {
 		c := conns[index]
-		if isOkConnection(c, allowBanned) {
+		if s.isOk(c, allowBanned) {
 			return c, 0
 		}
 		failedConns++
@@ -153,6 +250,16 @@ func sortPreferConnections(
 	return prefer, fallback
 }
 
+// isOk checks state of connection and outlier state of endpoint. Ejected endpoints
+// are used as last resort like banned connections. isOk not changes outlier state of endpoint
+func (s *connectionsState) isOk(c conn.Conn, allowBanned bool) bool {
+	if !isOkConnection(c, allowBanned) {
+		return false
+	}
+
+	return allowBanned || s.outliers == nil || s.outliers.allow(c)
+}
+
 func isOkConnection(c conn.Conn, bannedIsOk bool) bool {
 	switch c.GetState() {
 	case conn.Online, conn.Created, conn.Offline:
@@ -0,0 +1,96 @@
+package balancer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var _ discoveryClient = (*dnsDiscovery)(nil)
+
+type dnsResolver interface {
+	LookupHost(ctx context.Context, host string) (addrs []string, err error)
+	LookupSRV(ctx context.Context, service, proto, name string) (cname string, addrs []*net.SRV, err error)
+}
+
+// dnsDiscovery is a source of endpoints from A/AAAA records of hosts (with port of host)
+// or from SRV records of names
+type dnsDiscovery struct {
+	hosts    []string
+	srv      bool
+	resolver dnsResolver
+	nodes    *nodeResolver
+}
+
+func (d *dnsDiscovery) lookup(ctx context.Context, host string) (addresses []string, _ error) {
+	if d.srv {
+		_, records, err := d.resolver.LookupSRV(ctx, "", "", host)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		for _, r := range records {
+			addresses = append(addresses,
+				net.JoinHostPort(strings.TrimSuffix(r.Target, "."), strconv.Itoa(int(r.Port))),
+			)
+		}
+
+		return addresses, nil
+	}
+
+	host, port, err := net.SplitHostPort(host)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	ips, err := d.resolver.LookupHost(ctx, host)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	for _, ip := range ips {
+		addresses = append(addresses, net.JoinHostPort(ip, port))
+	}
+
+	return addresses, nil
+}
+
+func (d *dnsDiscovery) Discover(ctx context.Context) ([]endpoint.Endpoint, error) {
+	var (
+		endpoints []endpoint.Endpoint
+		seen      = make(map[string]struct{})
+		errs      []error
+	)
+	for _, host := range d.hosts {
+		addresses, err := d.lookup(ctx, host)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("lookup '%s' failed: %w", host, err))
+
+			continue
+		}
+		for _, address := range addresses {
+			if _, has := seen[address]; !has {
+				seen[address] = struct{}{}
+				endpoints = append(endpoints, endpoint.New(address))
+			}
+		}
+	}
+
+	if len(endpoints) == 0 {
+		if len(errs) > 0 {
+			return nil, xerrors.WithStackTrace(xerrors.Join(errs...))
+		}
+
+		return nil, xerrors.WithStackTrace(ErrNoEndpoints)
+	}
+
+	return d.nodes.resolve(endpoints), nil
+}
+
+func (d *dnsDiscovery) Close(context.Context) error {
+	d.nodes.close()
+
+	return nil
+}
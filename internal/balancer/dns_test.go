@@ -0,0 +1,158 @@
+package balancer
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+)
+
+type testDNSResolver struct {
+	hosts map[string][]string
+	srv   map[string][]*net.SRV
+}
+
+func (r *testDNSResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if addrs, has := r.hosts[host]; has {
+		return addrs, nil
+	}
+
+	return nil, errors.New("not found")
+}
+
+func (r *testDNSResolver) LookupSRV(ctx context.Context, service, proto, name string) (
+	string, []*net.SRV, error,
+) {
+	if addrs, has := r.srv[name]; has {
+		return name, addrs, nil
+	}
+
+	return "", nil, errors.New("not found")
+}
+
+func addresses(endpoints []endpoint.Endpoint) (addresses []string) {
+	for _, e := range endpoints {
+		addresses = append(addresses, e.Address())
+	}
+
+	return addresses
+}
+
+func TestDNSDiscovery(t *testing.T) {
+	resolver := &testDNSResolver{
+		hosts: map[string][]string{
+			"ydb.svc":  {"10.0.0.1", "10.0.0.2"},
+			"ydb2.svc": {"10.0.0.2", "10.0.0.3"},
+		},
+		srv: map[string][]*net.SRV{
+			"_grpc._tcp.ydb.svc": {
+				{Target: "ydb-0.ydb.svc.", Port: 2135},
+				{Target: "ydb-1.ydb.svc.", Port: 2136},
+			},
+		},
+	}
+	noNodes := func(ctx context.Context, address string) ([]endpoint.Endpoint, error) {
+		return nil, errors.New("no discovery")
+	}
+
+	t.Run("A", func(t *testing.T) {
+		d := &dnsDiscovery{
+			hosts:    []string{"ydb.svc:2135", "ydb2.svc:2135", "unknown.svc:2135"},
+			resolver: resolver,
+			nodes:    newNodeResolver(noNodes),
+		}
+		defer d.Close(context.Background())
+
+		endpoints, err := d.Discover(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []string{"10.0.0.1:2135", "10.0.0.2:2135", "10.0.0.3:2135"}, addresses(endpoints))
+	})
+	t.Run("SRV", func(t *testing.T) {
+		d := &dnsDiscovery{
+			hosts:    []string{"_grpc._tcp.ydb.svc"},
+			srv:      true,
+			resolver: resolver,
+			nodes:    newNodeResolver(noNodes),
+		}
+		defer d.Close(context.Background())
+
+		endpoints, err := d.Discover(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []string{"ydb-0.ydb.svc:2135", "ydb-1.ydb.svc:2136"}, addresses(endpoints))
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		d := &dnsDiscovery{
+			hosts:    []string{"unknown.svc:2135"},
+			resolver: resolver,
+			nodes:    newNodeResolver(noNodes),
+		}
+		defer d.Close(context.Background())
+
+		_, err := d.Discover(context.Background())
+		require.Error(t, err)
+	})
+}
+
+func TestStaticDiscoveryLazyNodeIDs(t *testing.T) {
+	var (
+		discovered = make(chan struct{})
+		once       sync.Once
+	)
+	// unresolved endpoint 10.0.0.3:2135 makes refresh on each discovery
+	nodes := newNodeResolver(func(ctx context.Context, address string) ([]endpoint.Endpoint, error) {
+		defer once.Do(func() {
+			close(discovered)
+		})
+		require.Contains(t, []string{"10.0.0.1:2135", "10.0.0.2:2135", "10.0.0.3:2135"}, address)
+
+		return []endpoint.Endpoint{
+			endpoint.New("ydb-0.ydb.svc:2135", endpoint.WithID(1), endpoint.WithLocation("a")),
+			endpoint.New("ydb-1.ydb.svc:2135", endpoint.WithID(2), endpoint.WithLocation("b")),
+		}, nil
+	})
+	nodes.lookupHost = (&testDNSResolver{
+		hosts: map[string][]string{
+			"ydb-0.ydb.svc": {"10.0.0.1"},
+			"ydb-1.ydb.svc": {"10.0.0.2"},
+		},
+	}).LookupHost
+
+	d := &staticDiscovery{
+		addresses: []string{"10.0.0.1:2135", "10.0.0.2:2135", "10.0.0.3:2135"},
+		nodes:     nodes,
+	}
+	defer d.Close(context.Background())
+
+	// first discovery returns endpoints without node IDs
+	endpoints, err := d.Discover(context.Background())
+	require.NoError(t, err)
+	for _, e := range endpoints {
+		require.Zero(t, e.NodeID())
+	}
+
+	select {
+	case <-discovered:
+	case <-time.After(time.Second):
+		t.Fatal("node IDs not resolved")
+	}
+
+	require.Eventually(t, func() bool {
+		endpoints, err = d.Discover(context.Background())
+		require.NoError(t, err)
+
+		return endpoints[0].NodeID() == 1
+	}, time.Second, time.Millisecond)
+
+	require.Equal(t, []string{"10.0.0.1:2135", "10.0.0.2:2135", "10.0.0.3:2135"}, addresses(endpoints))
+	require.EqualValues(t, 1, endpoints[0].NodeID())
+	require.Equal(t, "a", endpoints[0].Location())
+	require.EqualValues(t, 2, endpoints[1].NodeID())
+	require.Equal(t, "b", endpoints[1].Location())
+	require.Zero(t, endpoints[2].NodeID())
+}
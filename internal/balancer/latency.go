@@ -0,0 +1,173 @@
+package balancer
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const defaultLatencyDecay = 10 * time.Second
+
+// endpointLatency is EWMA of round trip time and count of inflight calls of endpoint
+type endpointLatency struct {
+	inflight atomic.Int64
+
+	mu      sync.Mutex
+	ewma    float64
+	updated time.Time
+}
+
+// observe adds round trip time to EWMA. Weight of previous value decays exponentially with time
+// since previous observation, so EWMA of rarely used endpoint follows new round trip time quickly
+func (l *endpointLatency) observe(rtt time.Duration, now time.Time, decay time.Duration) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.updated.IsZero() {
+		l.ewma = float64(rtt)
+	} else {
+		w := math.Exp(-float64(now.Sub(l.updated)) / float64(decay))
+		l.ewma = l.ewma*w + float64(rtt)*(1-w)
+	}
+	l.updated = now
+
+	return time.Duration(l.ewma)
+}
+
+func (l *endpointLatency) latency() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return time.Duration(l.ewma)
+}
+
+// observed returns latency of endpoint and false if latency of endpoint is not observed yet
+func (l *endpointLatency) observed() (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return time.Duration(l.ewma), !l.updated.IsZero()
+}
+
+// score is used for choice of endpoint, lower is better
+func score(latency time.Duration, inflight int64) float64 {
+	return float64(latency) * float64(inflight+1)
+}
+
+// latencyTracker tracks latencies of endpoints between discovery rounds
+type latencyTracker struct {
+	decay time.Duration
+	trace *trace.Driver
+	now   func() time.Time
+
+	mu        sync.RWMutex
+	latencies map[string]*endpointLatency
+}
+
+func newLatencyTracker(decay time.Duration, t *trace.Driver) *latencyTracker {
+	if decay <= 0 {
+		decay = defaultLatencyDecay
+	}
+
+	return &latencyTracker{
+		decay:     decay,
+		trace:     t,
+		now:       time.Now,
+		latencies: make(map[string]*endpointLatency),
+	}
+}
+
+func (t *latencyTracker) get(address string) *endpointLatency {
+	t.mu.RLock()
+	l, has := t.latencies[address]
+	t.mu.RUnlock()
+	if has {
+		return l
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if l, has = t.latencies[address]; !has {
+		l = &endpointLatency{}
+		t.latencies[address] = l
+	}
+
+	return l
+}
+
+// retain removes latencies of endpoints, which are not in addresses
+func (t *latencyTracker) retain(addresses map[string]struct{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for address := range t.latencies {
+		if _, has := addresses[address]; !has {
+			delete(t.latencies, address)
+		}
+	}
+}
+
+// score returns score of connection. Endpoint without observations is scored with median latency
+// of observed endpoints, so new endpoints get some calls for measure of latency, but not all calls.
+// If no one endpoint is observed - score depends on inflight calls only
+func (t *latencyTracker) score(c conn.Conn) float64 {
+	l := t.get(c.Endpoint().Address())
+
+	latency, ok := l.observed()
+	if !ok {
+		latency, ok = t.medianLatency()
+		if !ok {
+			latency = 1
+		}
+	}
+
+	return score(latency, l.inflight.Load())
+}
+
+// medianLatency returns median latency of observed endpoints and false if no one endpoint is observed
+func (t *latencyTracker) medianLatency() (time.Duration, bool) {
+	t.mu.RLock()
+	latencies := make([]time.Duration, 0, len(t.latencies))
+	for _, l := range t.latencies {
+		if latency, ok := l.observed(); ok {
+			latencies = append(latencies, latency)
+		}
+	}
+	t.mu.RUnlock()
+
+	if len(latencies) == 0 {
+		return 0, false
+	}
+
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+
+	return latencies[len(latencies)/2], true
+}
+
+// start registers call to connection. Returned function must be called on finish of call,
+// round trip time is observed only if observe is true
+func (t *latencyTracker) start(c conn.Conn) func(observe bool) {
+	var (
+		l     = t.get(c.Endpoint().Address())
+		start = t.now()
+	)
+	l.inflight.Add(1)
+
+	return func(observe bool) {
+		inflight := l.inflight.Add(-1)
+		latency := l.latency()
+		if observe {
+			now := t.now()
+			latency = l.observe(now.Sub(start), now, t.decay)
+		}
+		trace.DriverOnBalancerEndpointScore(t.trace, c.Endpoint(), latency, inflight, score(latency, inflight))
+	}
+}
@@ -0,0 +1,172 @@
+package balancer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/mock"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) call(tracker *latencyTracker, cc conn.Conn, rtt time.Duration) {
+	onDone := tracker.start(cc)
+	c.now = c.now.Add(rtt)
+	onDone(true)
+}
+
+func TestEndpointLatency(t *testing.T) {
+	var (
+		l     endpointLatency
+		now   = time.Unix(0, 0)
+		decay = time.Second
+	)
+	require.Equal(t, 100*time.Millisecond, l.observe(100*time.Millisecond, now, decay))
+	// weight of previous value is exp(-1)
+	require.InDelta(t,
+		float64(260*time.Millisecond),
+		float64(l.observe(354*time.Millisecond, now.Add(decay), decay)),
+		float64(time.Millisecond),
+	)
+	// previous value is forgotten after long pause
+	require.InDelta(t,
+		float64(10*time.Millisecond),
+		float64(l.observe(10*time.Millisecond, now.Add(time.Hour), decay)),
+		float64(time.Millisecond),
+	)
+}
+
+func TestLatencyTracker(t *testing.T) {
+	var (
+		clock  = &testClock{now: time.Unix(0, 0)}
+		scores []trace.DriverBalancerEndpointScoreInfo
+		fast   = &mock.Conn{AddrField: "fast", State: conn.Online}
+		slow   = &mock.Conn{AddrField: "slow", State: conn.Online}
+	)
+	tracker := newLatencyTracker(0, &trace.Driver{
+		OnBalancerEndpointScore: func(info trace.DriverBalancerEndpointScoreInfo) {
+			scores = append(scores, info)
+		},
+	})
+	tracker.now = clock.Now
+	require.Equal(t, defaultLatencyDecay, tracker.decay)
+
+	clock.call(tracker, fast, time.Millisecond)
+	clock.call(tracker, slow, 10*time.Millisecond)
+	require.Len(t, scores, 2)
+	require.Equal(t, "slow", scores[1].Endpoint.Address())
+	require.Equal(t, 10*time.Millisecond, scores[1].Latency)
+	require.Equal(t, int64(0), scores[1].Inflight)
+	require.Less(t, tracker.score(fast), tracker.score(slow))
+
+	// inflight calls increase score
+	onDone := make([]func(bool), 0, 20)
+	for i := 0; i < 20; i++ {
+		onDone = append(onDone, tracker.start(fast))
+	}
+	require.Greater(t, tracker.score(fast), tracker.score(slow))
+	for _, done := range onDone {
+		done(false)
+	}
+	require.Less(t, tracker.score(fast), tracker.score(slow))
+
+	tracker.retain(map[string]struct{}{"fast": {}})
+	require.Len(t, tracker.latencies, 1)
+	// not observed endpoint is scored with median latency of observed endpoints
+	require.Equal(t, float64(time.Millisecond), tracker.score(slow))
+}
+
+func TestLatencyTrackerColdEndpoints(t *testing.T) {
+	var (
+		clock   = &testClock{now: time.Unix(0, 0)}
+		tracker = newLatencyTracker(0, &trace.Driver{})
+		a       = &mock.Conn{AddrField: "a", State: conn.Online}
+		b       = &mock.Conn{AddrField: "b", State: conn.Online}
+		c       = &mock.Conn{AddrField: "c", State: conn.Online}
+		cold    = &mock.Conn{AddrField: "cold", State: conn.Online}
+	)
+	tracker.now = clock.Now
+
+	// no one endpoint observed - score depends on inflight calls only
+	done := tracker.start(a)
+	require.Greater(t, tracker.score(a), tracker.score(cold))
+	require.Greater(t, tracker.score(cold), float64(0))
+	done(false)
+	require.Equal(t, tracker.score(a), tracker.score(cold))
+
+	clock.call(tracker, a, time.Millisecond)
+	clock.call(tracker, b, 5*time.Millisecond)
+	clock.call(tracker, c, 10*time.Millisecond)
+	require.Equal(t, float64(5*time.Millisecond), tracker.score(cold))
+	require.Less(t, tracker.score(a), tracker.score(cold))
+	require.Less(t, tracker.score(cold), tracker.score(c))
+
+	// inflight calls of cold endpoint increase score
+	for i := 0; i < 2; i++ {
+		tracker.start(cold)
+	}
+	require.Greater(t, tracker.score(cold), tracker.score(c))
+}
+
+func TestLeastLatencyConnection(t *testing.T) {
+	clock := &testClock{now: time.Unix(0, 0)}
+	tracker := newLatencyTracker(time.Second, &trace.Driver{})
+	tracker.now = clock.Now
+
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "1", State: conn.Online, LocationField: "a"},
+		&mock.Conn{AddrField: "2", State: conn.Online, LocationField: "a"},
+		&mock.Conn{AddrField: "3", State: conn.Online, LocationField: "a"},
+		&mock.Conn{AddrField: "4", State: conn.Online, LocationField: "b"},
+	}
+	for i, c := range conns {
+		clock.call(tracker, c, time.Duration(4-i)*time.Millisecond)
+	}
+
+	t.Run("WorstIsNeverChosen", func(t *testing.T) {
+		s := newConnectionsState(conns[:3], nil, balancerConfig.Info{}, false)
+		s.latencies = tracker
+		chosen := make(map[string]int)
+		for i := 0; i < 100; i++ {
+			c, failed := s.GetConnection(context.Background())
+			require.Equal(t, 0, failed)
+			chosen[c.Endpoint().Address()]++
+		}
+		require.Zero(t, chosen["1"])
+		require.Greater(t, chosen["3"], chosen["2"])
+	})
+	t.Run("Prefer", func(t *testing.T) {
+		s := newConnectionsState(conns, filterFunc(func(info balancerConfig.Info, c conn.Conn) bool {
+			return c.Endpoint().Location() == info.SelfLocation
+		}), balancerConfig.Info{SelfLocation: "a"}, true)
+		s.latencies = tracker
+		for i := 0; i < 100; i++ {
+			c, _ := s.GetConnection(context.Background())
+			require.NotEqual(t, "4", c.Endpoint().Address())
+		}
+	})
+	t.Run("Banned", func(t *testing.T) {
+		s := newConnectionsState([]conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Online},
+			&mock.Conn{AddrField: "3", State: conn.Banned},
+		}, nil, balancerConfig.Info{}, false)
+		s.latencies = tracker
+		for i := 0; i < 10; i++ {
+			c, failed := s.GetConnection(context.Background())
+			require.Equal(t, 0, failed)
+			require.Equal(t, "1", c.Endpoint().Address())
+		}
+	})
+}
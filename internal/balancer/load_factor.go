@@ -0,0 +1,54 @@
+package balancer
+
+import (
+	"math"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+)
+
+// loadFactorBias limits weight of idle endpoint: weight of endpoint with zero load factor
+// is 11 times greater than weight of endpoint with load factor 1
+const loadFactorBias = 0.1
+
+func loadFactorWeight(loadFactor float64) float64 {
+	return 1 / (loadFactorBias + loadFactor)
+}
+
+// loadFactors is smoothed load factors of endpoints between discovery rounds
+type loadFactors struct {
+	damping float64
+
+	mu       sync.Mutex
+	smoothed map[string]float64
+}
+
+func newLoadFactors(damping float64) *loadFactors {
+	return &loadFactors{
+		damping:  math.Min(math.Max(damping, 0), 1),
+		smoothed: make(map[string]float64),
+	}
+}
+
+// update smooths load factors of discovered endpoints with previous load factors and returns
+// weights of endpoints by address. Load factors of not discovered endpoints are forgotten
+func (f *loadFactors) update(endpoints []endpoint.Endpoint) map[string]float64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var (
+		smoothed = make(map[string]float64, len(endpoints))
+		weights  = make(map[string]float64, len(endpoints))
+	)
+	for _, e := range endpoints {
+		loadFactor := math.Max(float64(e.LoadFactor()), 0)
+		if previous, has := f.smoothed[e.Address()]; has {
+			loadFactor = f.damping*previous + (1-f.damping)*loadFactor
+		}
+		smoothed[e.Address()] = loadFactor
+		weights[e.Address()] = loadFactorWeight(loadFactor)
+	}
+	f.smoothed = smoothed
+
+	return weights
+}
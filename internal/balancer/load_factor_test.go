@@ -0,0 +1,93 @@
+package balancer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/mock"
+)
+
+func TestLoadFactors(t *testing.T) {
+	f := newLoadFactors(0.5)
+
+	weights := f.update([]endpoint.Endpoint{
+		&mock.Endpoint{AddrField: "1", LoadFactorField: 0},
+		&mock.Endpoint{AddrField: "2", LoadFactorField: 1},
+		&mock.Endpoint{AddrField: "3", LoadFactorField: -1},
+	})
+	require.InDelta(t, 10, weights["1"], 1e-9)
+	require.InDelta(t, 1/1.1, weights["2"], 1e-9)
+	require.InDelta(t, 10, weights["3"], 1e-9)
+
+	// load factors are damped
+	weights = f.update([]endpoint.Endpoint{
+		&mock.Endpoint{AddrField: "1", LoadFactorField: 1},
+		&mock.Endpoint{AddrField: "2", LoadFactorField: 0},
+	})
+	require.InDelta(t, 1/0.6, weights["1"], 1e-9)
+	require.InDelta(t, 1/0.6, weights["2"], 1e-9)
+	require.Len(t, f.smoothed, 2)
+
+	// without damping load factors are used as is
+	f = newLoadFactors(0)
+	f.update([]endpoint.Endpoint{&mock.Endpoint{AddrField: "1", LoadFactorField: 1}})
+	weights = f.update([]endpoint.Endpoint{&mock.Endpoint{AddrField: "1", LoadFactorField: 0}})
+	require.InDelta(t, 10, weights["1"], 1e-9)
+}
+
+func TestWeightedConnection(t *testing.T) {
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "idle", State: conn.Online, LocationField: "a"},
+		&mock.Conn{AddrField: "busy", State: conn.Online, LocationField: "a"},
+		&mock.Conn{AddrField: "other", State: conn.Online, LocationField: "b"},
+	}
+	weights := newLoadFactors(0).update([]endpoint.Endpoint{
+		&mock.Endpoint{AddrField: "idle", LoadFactorField: 0},
+		&mock.Endpoint{AddrField: "busy", LoadFactorField: 1},
+		&mock.Endpoint{AddrField: "other", LoadFactorField: 0},
+	})
+
+	t.Run("Prefer", func(t *testing.T) {
+		s := newConnectionsState(conns, filterFunc(func(info balancerConfig.Info, c conn.Conn) bool {
+			return c.Endpoint().Location() == info.SelfLocation
+		}), balancerConfig.Info{SelfLocation: "a"}, true)
+		s.weights = weights
+		chosen := make(map[string]int)
+		for i := 0; i < 1000; i++ {
+			c, failed := s.GetConnection(context.Background())
+			require.Equal(t, 0, failed)
+			chosen[c.Endpoint().Address()]++
+		}
+		require.Zero(t, chosen["other"])
+		// expected ratio of calls is 11
+		require.Greater(t, chosen["idle"], 5*chosen["busy"])
+		require.Positive(t, chosen["busy"])
+	})
+	t.Run("Banned", func(t *testing.T) {
+		s := newConnectionsState([]conn.Conn{
+			&mock.Conn{AddrField: "idle", State: conn.Banned},
+			&mock.Conn{AddrField: "busy", State: conn.Online},
+		}, nil, balancerConfig.Info{}, false)
+		s.weights = weights
+		for i := 0; i < 10; i++ {
+			c, failed := s.GetConnection(context.Background())
+			require.Equal(t, 0, failed)
+			require.Equal(t, "busy", c.Endpoint().Address())
+		}
+	})
+	t.Run("AllBanned", func(t *testing.T) {
+		s := newConnectionsState([]conn.Conn{
+			&mock.Conn{AddrField: "idle", State: conn.Banned},
+			&mock.Conn{AddrField: "busy", State: conn.Banned},
+		}, nil, balancerConfig.Info{}, false)
+		s.weights = weights
+		c, failed := s.GetConnection(context.Background())
+		require.NotNil(t, c)
+		require.Equal(t, 2, failed)
+	})
+}
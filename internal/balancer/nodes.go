@@ -0,0 +1,129 @@
+package balancer
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+)
+
+const nodeResolveTimeout = 10 * time.Second
+
+// nodeResolver resolves node IDs of endpoints from static or DNS discovery lazily: endpoints
+// are used without node IDs, while node IDs are resolved in background with discovery service
+// of any endpoint and applied on next discovery round
+type nodeResolver struct {
+	discover   func(ctx context.Context, address string) ([]endpoint.Endpoint, error)
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+
+	ctx    context.Context //nolint:containedctx
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu        sync.Mutex
+	nodes     map[string]endpoint.Endpoint
+	resolving bool
+	attempts  int
+}
+
+func newNodeResolver(
+	discover func(ctx context.Context, address string) ([]endpoint.Endpoint, error),
+) *nodeResolver {
+	ctx, cancel := xcontext.WithCancel(context.Background())
+
+	return &nodeResolver{
+		discover:   discover,
+		lookupHost: net.DefaultResolver.LookupHost,
+		ctx:        ctx,
+		cancel:     cancel,
+		nodes:      make(map[string]endpoint.Endpoint),
+	}
+}
+
+// resolve returns endpoints with known node IDs and starts background resolving of unknown node IDs
+func (r *nodeResolver) resolve(endpoints []endpoint.Endpoint) []endpoint.Endpoint {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	resolved := make([]endpoint.Endpoint, 0, len(endpoints))
+	unresolved := false
+	for _, e := range endpoints {
+		node, has := r.nodes[e.Address()]
+		if !has {
+			unresolved = true
+			resolved = append(resolved, e)
+
+			continue
+		}
+		resolved = append(resolved, endpoint.New(e.Address(),
+			endpoint.WithID(node.NodeID()),
+			endpoint.WithLocation(node.Location()),
+			endpoint.WithLocalDC(node.LocalDC()),
+			endpoint.WithLoadFactor(node.LoadFactor()),
+		))
+	}
+
+	if unresolved && !r.resolving && len(endpoints) > 0 && r.ctx.Err() == nil {
+		// each attempt uses next endpoint, so broken endpoint not blocks resolving
+		address := endpoints[r.attempts%len(endpoints)].Address()
+		r.attempts++
+		r.resolving = true
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			r.refresh(address)
+		}()
+	}
+
+	return resolved
+}
+
+// refresh updates node IDs by discovery service of endpoint with address. Discovered endpoints
+// are matched by address and by resolved IP addresses of discovered hosts
+func (r *nodeResolver) refresh(address string) {
+	ctx, cancel := xcontext.WithTimeout(r.ctx, nodeResolveTimeout)
+	defer cancel()
+
+	defer func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.resolving = false
+	}()
+
+	endpoints, err := r.discover(ctx, address)
+	if err != nil {
+		return
+	}
+
+	nodes := make(map[string]endpoint.Endpoint, len(endpoints))
+	for _, e := range endpoints {
+		nodes[e.Address()] = e
+		host, port, err := net.SplitHostPort(e.Address())
+		if err != nil {
+			continue
+		}
+		ips, err := r.lookupHost(ctx, host)
+		if err != nil {
+			continue
+		}
+		for _, ip := range ips {
+			if _, has := nodes[net.JoinHostPort(ip, port)]; !has {
+				nodes[net.JoinHostPort(ip, port)] = e
+			}
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nodes = nodes
+}
+
+func (r *nodeResolver) close() {
+	r.cancel()
+	r.wg.Wait()
+}
@@ -0,0 +1,303 @@
+package balancer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const (
+	defaultOutlierWindow            = 10 * time.Second
+	defaultOutlierBaseEjectionTime  = 30 * time.Second
+	defaultOutlierMaxEjectionTime   = 5 * time.Minute
+	defaultOutlierMaxEjectedPercent = 10
+
+	outlierBuckets = 10
+)
+
+// isOutlierFailure checks error of call is a failure of endpoint: transport error, which pessimizes
+// endpoint, or overloaded or unavailable node
+func isOutlierFailure(err error, excludeCodes ...grpcCodes.Code) bool {
+	return xerrors.MustPessimizeEndpoint(err, excludeCodes...) ||
+		xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED, Ydb.StatusIds_UNAVAILABLE)
+}
+
+type outlierState int
+
+const (
+	outlierHealthy = outlierState(iota)
+	outlierEjected
+	outlierHalfOpen
+)
+
+func (s outlierState) String() string {
+	switch s {
+	case outlierHealthy:
+		return "healthy"
+	case outlierEjected:
+		return "ejected"
+	case outlierHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+type outlierBucket struct {
+	id       int64
+	calls    int
+	failures int
+}
+
+type endpointOutlier struct {
+	state outlierState
+
+	consecutiveFailures int
+	buckets             [outlierBuckets]outlierBucket
+
+	// ejections is count of ejections for exponential ejection time
+	ejections    int
+	ejectedUntil time.Time
+	healthySince time.Time
+	probing      bool
+}
+
+// outlierDetector ejects endpoints with many failed or slow calls. Ejected endpoint is not chosen
+// by balancer until ejection time expired, then endpoint becomes half-open and one probe call
+// decides about return of endpoint to healthy state or next ejection with doubled ejection time
+type outlierDetector struct {
+	config balancerConfig.OutlierDetection
+	trace  *trace.Driver
+	now    func() time.Time
+
+	mu        sync.RWMutex
+	endpoints map[string]*endpointOutlier
+	total     int
+	ejected   int
+}
+
+func newOutlierDetector(config balancerConfig.OutlierDetection, t *trace.Driver) *outlierDetector {
+	if config.Window <= 0 {
+		config.Window = defaultOutlierWindow
+	}
+	if config.BaseEjectionTime <= 0 {
+		config.BaseEjectionTime = defaultOutlierBaseEjectionTime
+	}
+	if config.MaxEjectionTime <= 0 {
+		config.MaxEjectionTime = defaultOutlierMaxEjectionTime
+	}
+	if config.MaxEjectionTime < config.BaseEjectionTime {
+		config.MaxEjectionTime = config.BaseEjectionTime
+	}
+	if config.MaxEjectedPercent <= 0 {
+		config.MaxEjectedPercent = defaultOutlierMaxEjectedPercent
+	}
+
+	return &outlierDetector{
+		config:    config,
+		trace:     t,
+		now:       time.Now,
+		endpoints: make(map[string]*endpointOutlier),
+	}
+}
+
+func (d *outlierDetector) get(address string) *endpointOutlier {
+	o, has := d.endpoints[address]
+	if !has {
+		o = &endpointOutlier{}
+		d.endpoints[address] = o
+	}
+
+	return o
+}
+
+// retain removes states of endpoints, which are not in addresses
+func (d *outlierDetector) retain(addresses map[string]struct{}) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.total = len(addresses)
+	d.ejected = 0
+	for address, o := range d.endpoints {
+		if _, has := addresses[address]; !has {
+			delete(d.endpoints, address)
+		} else if o.state != outlierHealthy {
+			d.ejected++
+		}
+	}
+}
+
+// allow returns false if endpoint is ejected or half-open endpoint is already probing.
+// allow not changes state of endpoint, because balancer checks many connections before choice,
+// ejected endpoint with expired ejection time becomes half-open on start of call
+func (d *outlierDetector) allow(c conn.Conn) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	o, has := d.endpoints[c.Endpoint().Address()]
+	if !has {
+		return true
+	}
+
+	switch o.state {
+	case outlierEjected:
+		return !d.now().Before(o.ejectedUntil)
+	case outlierHalfOpen:
+		return !o.probing
+	default:
+		return true
+	}
+}
+
+// start registers call to connection. Returned function must be called on finish of call
+// with result of call
+func (d *outlierDetector) start(c conn.Conn) func(failed bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var (
+		o     = d.get(c.Endpoint().Address())
+		start = d.now()
+	)
+	if o.state == outlierEjected && !start.Before(o.ejectedUntil) {
+		d.setState(c, o, outlierHalfOpen, "ejection time expired")
+	}
+	probe := o.state == outlierHalfOpen && !o.probing
+	if probe {
+		o.probing = true
+	}
+
+	return func(failed bool) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		now := d.now()
+		if d.config.SlowCallDuration > 0 && now.Sub(start) > d.config.SlowCallDuration {
+			failed = true
+		}
+
+		if probe {
+			o.probing = false
+			if o.state != outlierHalfOpen {
+				return
+			}
+			if failed {
+				d.eject(c, o, now, "probe failed")
+			} else {
+				d.setState(c, o, outlierHealthy, "probe succeeded")
+				o.healthySince = now
+				o.consecutiveFailures = 0
+				o.buckets = [outlierBuckets]outlierBucket{}
+			}
+
+			return
+		}
+
+		if o.state != outlierHealthy {
+			return
+		}
+
+		if reason := d.record(o, now, failed); reason != "" && d.canEject() {
+			d.eject(c, o, now, reason)
+		}
+	}
+}
+
+// record adds result of call to statistics of endpoint and returns reason of ejection if endpoint is outlier
+func (d *outlierDetector) record(o *endpointOutlier, now time.Time, failed bool) (reason string) {
+	width := int64(d.config.Window / outlierBuckets)
+	if width <= 0 {
+		width = 1
+	}
+	var (
+		id = now.UnixNano() / width
+		b  = &o.buckets[id%outlierBuckets]
+	)
+	if b.id != id {
+		*b = outlierBucket{id: id}
+	}
+	b.calls++
+
+	if !failed {
+		o.consecutiveFailures = 0
+
+		return ""
+	}
+
+	b.failures++
+	o.consecutiveFailures++
+
+	if d.config.ConsecutiveFailures > 0 && o.consecutiveFailures >= d.config.ConsecutiveFailures {
+		return "consecutive failures"
+	}
+
+	if d.config.ErrorRate > 0 {
+		var calls, failures int
+		for i := range o.buckets {
+			if o.buckets[i].id > id-outlierBuckets {
+				calls += o.buckets[i].calls
+				failures += o.buckets[i].failures
+			}
+		}
+		if calls >= d.config.MinRequests && float64(failures) >= d.config.ErrorRate*float64(calls) {
+			return "error rate"
+		}
+	}
+
+	return ""
+}
+
+// canEject checks limit of ejected endpoints, one endpoint may be ejected always
+func (d *outlierDetector) canEject() bool {
+	maxEjected := d.total * d.config.MaxEjectedPercent / 100
+	if maxEjected < 1 {
+		maxEjected = 1
+	}
+
+	return d.ejected < maxEjected
+}
+
+func (d *outlierDetector) eject(c conn.Conn, o *endpointOutlier, now time.Time, reason string) {
+	if o.state == outlierHealthy {
+		d.ejected++
+		// endpoint was healthy long time, so ejection time is reset to base ejection time
+		if !o.healthySince.IsZero() && now.Sub(o.healthySince) > d.config.MaxEjectionTime {
+			o.ejections = 0
+		}
+	}
+
+	ejectionTime := d.config.BaseEjectionTime
+	for i := 0; i < o.ejections && ejectionTime < d.config.MaxEjectionTime; i++ {
+		ejectionTime *= 2
+	}
+	if ejectionTime > d.config.MaxEjectionTime {
+		ejectionTime = d.config.MaxEjectionTime
+	}
+	o.ejections++
+	o.ejectedUntil = now.Add(ejectionTime)
+	o.consecutiveFailures = 0
+
+	previous := o.state
+	o.state = outlierEjected
+	trace.DriverOnBalancerOutlierStateChange(d.trace,
+		c.Endpoint().Copy(), previous.String(), o.state.String(), reason, ejectionTime,
+	)
+}
+
+func (d *outlierDetector) setState(c conn.Conn, o *endpointOutlier, state outlierState, reason string) {
+	previous := o.state
+	o.state = state
+	if state == outlierHealthy {
+		d.ejected--
+	}
+	trace.DriverOnBalancerOutlierStateChange(d.trace,
+		c.Endpoint().Copy(), previous.String(), state.String(), reason, 0,
+	)
+}
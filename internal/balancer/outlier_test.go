@@ -0,0 +1,248 @@
+package balancer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/mock"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type testOutlierDetector struct {
+	*outlierDetector
+
+	clock       *testClock
+	transitions []string
+}
+
+func newTestOutlierDetector(config balancerConfig.OutlierDetection, addresses ...string) *testOutlierDetector {
+	d := &testOutlierDetector{
+		clock: &testClock{now: time.Unix(0, 0)},
+	}
+	d.outlierDetector = newOutlierDetector(config, &trace.Driver{
+		OnBalancerOutlierStateChange: func(info trace.DriverBalancerOutlierStateChangeInfo) {
+			d.transitions = append(d.transitions,
+				info.Endpoint.Address()+": "+info.PreviousState+" -> "+info.State+" ("+info.Reason+")",
+			)
+		},
+	})
+	d.now = d.clock.Now
+	set := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		set[address] = struct{}{}
+	}
+	d.retain(set)
+
+	return d
+}
+
+func (d *testOutlierDetector) call(c conn.Conn, rtt time.Duration, failed bool) {
+	onDone := d.start(c)
+	d.clock.now = d.clock.now.Add(rtt)
+	onDone(failed)
+}
+
+func TestOutlierDetectorConsecutiveFailures(t *testing.T) {
+	var (
+		d = newTestOutlierDetector(balancerConfig.OutlierDetection{
+			ConsecutiveFailures: 3,
+			BaseEjectionTime:    time.Second,
+			MaxEjectionTime:     3 * time.Second,
+		}, "1", "2")
+		c = &mock.Conn{AddrField: "1", State: conn.Online}
+	)
+
+	d.call(c, time.Millisecond, true)
+	d.call(c, time.Millisecond, true)
+	d.call(c, time.Millisecond, false)
+	d.call(c, time.Millisecond, true)
+	d.call(c, time.Millisecond, true)
+	require.True(t, d.allow(c))
+	d.call(c, time.Millisecond, true)
+	require.False(t, d.allow(c))
+	require.Equal(t, []string{"1: healthy -> ejected (consecutive failures)"}, d.transitions)
+
+	// probe failed, ejection time doubles
+	d.clock.now = d.clock.now.Add(time.Second)
+	require.True(t, d.allow(c))
+	// check of endpoint not changes state
+	require.True(t, d.allow(c))
+	require.Equal(t, []string{"1: healthy -> ejected (consecutive failures)"}, d.transitions)
+	onDone := d.start(c)
+	// only one probe call
+	require.False(t, d.allow(c))
+	onDone(true)
+	require.False(t, d.allow(c))
+	d.clock.now = d.clock.now.Add(time.Second)
+	require.False(t, d.allow(c))
+	d.clock.now = d.clock.now.Add(time.Second)
+	require.True(t, d.allow(c))
+
+	// probe succeeded
+	d.call(c, time.Millisecond, false)
+	require.True(t, d.allow(c))
+	require.Equal(t, []string{
+		"1: healthy -> ejected (consecutive failures)",
+		"1: ejected -> half-open (ejection time expired)",
+		"1: half-open -> ejected (probe failed)",
+		"1: ejected -> half-open (ejection time expired)",
+		"1: half-open -> healthy (probe succeeded)",
+	}, d.transitions)
+	require.Equal(t, 0, d.ejected)
+}
+
+func TestOutlierDetectorErrorRate(t *testing.T) {
+	var (
+		d = newTestOutlierDetector(balancerConfig.OutlierDetection{
+			ErrorRate:   0.5,
+			MinRequests: 10,
+			Window:      time.Second,
+		}, "1")
+		c = &mock.Conn{AddrField: "1", State: conn.Online}
+	)
+
+	// failures out of window are forgotten
+	for i := 0; i < 9; i++ {
+		d.call(c, time.Millisecond, true)
+	}
+	d.clock.now = d.clock.now.Add(2 * time.Second)
+	for i := 0; i < 4; i++ {
+		d.call(c, time.Millisecond, false)
+		d.call(c, time.Millisecond, true)
+	}
+	require.True(t, d.allow(c))
+	d.call(c, time.Millisecond, false)
+	d.call(c, time.Millisecond, true)
+	require.False(t, d.allow(c))
+	require.Equal(t, []string{"1: healthy -> ejected (error rate)"}, d.transitions)
+}
+
+func TestOutlierDetectorSlowCalls(t *testing.T) {
+	var (
+		d = newTestOutlierDetector(balancerConfig.OutlierDetection{
+			ConsecutiveFailures: 2,
+			SlowCallDuration:    100 * time.Millisecond,
+		}, "1")
+		c = &mock.Conn{AddrField: "1", State: conn.Online}
+	)
+
+	d.call(c, time.Second, false)
+	d.call(c, time.Second, false)
+	require.False(t, d.allow(c))
+}
+
+func TestOutlierDetectorMaxEjectedPercent(t *testing.T) {
+	var (
+		d = newTestOutlierDetector(balancerConfig.OutlierDetection{
+			ConsecutiveFailures: 1,
+			MaxEjectedPercent:   67,
+		}, "1", "2", "3", "4")
+		conns = []conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Online},
+			&mock.Conn{AddrField: "2", State: conn.Online},
+			&mock.Conn{AddrField: "3", State: conn.Online},
+			&mock.Conn{AddrField: "4", State: conn.Online},
+		}
+	)
+
+	for _, c := range conns {
+		d.call(c, time.Millisecond, true)
+	}
+	require.False(t, d.allow(conns[0]))
+	require.False(t, d.allow(conns[1]))
+	require.True(t, d.allow(conns[2]))
+	require.True(t, d.allow(conns[3]))
+
+	// dropped endpoints are not counted
+	d.retain(map[string]struct{}{"2": {}, "3": {}, "4": {}})
+	d.call(conns[2], time.Millisecond, true)
+	require.False(t, d.allow(conns[2]))
+	require.Equal(t, 2, d.ejected)
+}
+
+func TestOutlierConnection(t *testing.T) {
+	d := newTestOutlierDetector(balancerConfig.OutlierDetection{
+		ConsecutiveFailures: 1,
+		MaxEjectedPercent:   100,
+	}, "1", "2")
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "1", State: conn.Online},
+		&mock.Conn{AddrField: "2", State: conn.Online},
+	}
+	s := newConnectionsState(conns, nil, balancerConfig.Info{}, false)
+	s.outliers = d.outlierDetector
+
+	d.call(conns[0], time.Millisecond, true)
+	for i := 0; i < 10; i++ {
+		c, failed := s.GetConnection(context.Background())
+		require.Equal(t, 0, failed)
+		require.Equal(t, "2", c.Endpoint().Address())
+	}
+
+	// all endpoints are ejected, so ejected endpoints are used as last resort
+	d.call(conns[1], time.Millisecond, true)
+	c, failed := s.GetConnection(context.Background())
+	require.NotNil(t, c)
+	require.Equal(t, 2, failed)
+}
+
+func TestOutlierConnectionSelectNotChangesState(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		setup func(s *connectionsState)
+	}{
+		{
+			name:  "Random",
+			setup: func(s *connectionsState) {},
+		},
+		{
+			name: "Weighted",
+			setup: func(s *connectionsState) {
+				s.weights = map[string]float64{"1": 1, "2": 1, "3": 1}
+			},
+		},
+		{
+			name: "LeastLatency",
+			setup: func(s *connectionsState) {
+				s.latencies = newLatencyTracker(time.Second, &trace.Driver{})
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newTestOutlierDetector(balancerConfig.OutlierDetection{
+				ConsecutiveFailures: 1,
+				BaseEjectionTime:    time.Second,
+				MaxEjectedPercent:   100,
+			}, "1", "2", "3")
+			conns := []conn.Conn{
+				&mock.Conn{AddrField: "1", State: conn.Online},
+				&mock.Conn{AddrField: "2", State: conn.Online},
+				&mock.Conn{AddrField: "3", State: conn.Online},
+			}
+			d.call(conns[0], time.Millisecond, true)
+			d.clock.now = d.clock.now.Add(time.Second)
+
+			s := newConnectionsState(conns, nil, balancerConfig.Info{}, false)
+			s.outliers = d.outlierDetector
+			tt.setup(s)
+			for i := 0; i < 10; i++ {
+				c, failed := s.GetConnection(context.Background())
+				require.NotNil(t, c)
+				require.Equal(t, 0, failed)
+			}
+			// ejected endpoint becomes half-open only on start of call to chosen connection
+			require.Equal(t, []string{"1: healthy -> ejected (consecutive failures)"}, d.transitions)
+			d.call(conns[0], time.Millisecond, false)
+			require.Equal(t, []string{
+				"1: healthy -> ejected (consecutive failures)",
+				"1: ejected -> half-open (ejection time expired)",
+				"1: half-open -> healthy (probe succeeded)",
+			}, d.transitions)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package balancer
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var _ discoveryClient = (*staticDiscovery)(nil)
+
+// staticDiscovery is a source of static endpoints without discovery service
+type staticDiscovery struct {
+	addresses []string
+	nodes     *nodeResolver
+}
+
+func (d *staticDiscovery) Discover(ctx context.Context) ([]endpoint.Endpoint, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if len(d.addresses) == 0 {
+		return nil, xerrors.WithStackTrace(ErrNoEndpoints)
+	}
+
+	endpoints := make([]endpoint.Endpoint, 0, len(d.addresses))
+	for _, address := range d.addresses {
+		endpoints = append(endpoints, endpoint.New(address))
+	}
+
+	return d.nodes.resolve(endpoints), nil
+}
+
+func (d *staticDiscovery) Close(context.Context) error {
+	d.nodes.close()
+
+	return nil
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -11,6 +12,7 @@ type Common struct {
 	operationCancelAfter time.Duration
 	disableAutoRetry     bool
 	traceRetry           trace.Retry
+	retryBudget          *retry.Budget
 
 	panicCallback func(e interface{})
 }
@@ -48,6 +50,12 @@ func (c *Common) TraceRetry() *trace.Retry {
 	return &c.traceRetry
 }
 
+// RetryBudget is a shared retry budget of retry loops of clients
+// If nil - retries are not limited by budget.
+func (c *Common) RetryBudget() *retry.Budget {
+	return c.retryBudget
+}
+
 // SetOperationTimeout define the maximum amount of time a YDB server will process
 // an operation. After timeout exceeds YDB will try to cancel operation and
 // regardless of the cancellation appropriate error will be returned to
@@ -78,6 +86,11 @@ func SetAutoRetry(c *Common, autoRetry bool) {
 	c.disableAutoRetry = !autoRetry
 }
 
+// SetRetryBudget applies shared retry budget to config.
+func SetRetryBudget(c *Common, b *retry.Budget) {
+	c.retryBudget = b
+}
+
 func SetTraceRetry(c *Common, t *trace.Retry, opts ...trace.RetryComposeOption) {
 	c.traceRetry = *c.traceRetry.Compose(t, opts...)
 }
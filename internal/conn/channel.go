@@ -0,0 +1,44 @@
+package conn
+
+import (
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// channel is a single grpc connection to endpoint. Endpoint connection holds several channels
+// for unary and streaming calls. Fields cc and lastUsage are guarded by mutex of conn
+type channel struct {
+	cc        *grpc.ClientConn
+	lastUsage time.Time
+	inflight  atomic.Int64
+}
+
+func newChannels(count int) []*channel {
+	if count < 1 {
+		count = 1
+	}
+	channels := make([]*channel, count)
+	for i := range channels {
+		channels[i] = &channel{}
+	}
+
+	return channels
+}
+
+// leastLoaded returns channel with minimal count of inflight calls. Channels with equal load
+// are chosen in order, so channels are dialed only on concurrent calls
+func leastLoaded(channels []*channel) *channel {
+	var (
+		chosen   = channels[0]
+		inflight = chosen.inflight.Load()
+	)
+	for _, ch := range channels[1:] {
+		if n := ch.inflight.Load(); n < inflight {
+			chosen, inflight = ch, n
+		}
+	}
+
+	return chosen
+}
@@ -0,0 +1,195 @@
+package conn
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type testConfig struct {
+	channels       int
+	streamChannels int
+}
+
+func (c testConfig) DialTimeout() time.Duration {
+	return 0
+}
+
+func (c testConfig) Trace() *trace.Driver {
+	return &trace.Driver{}
+}
+
+func (c testConfig) ConnectionTTL() time.Duration {
+	return 0
+}
+
+func (c testConfig) GrpcDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+}
+
+func (c testConfig) ChannelsPerEndpoint() int {
+	return c.channels
+}
+
+func (c testConfig) StreamingChannelsPerEndpoint() int {
+	return c.streamChannels
+}
+
+func TestLeastLoaded(t *testing.T) {
+	channels := newChannels(3)
+	require.Equal(t, channels[0], leastLoaded(channels))
+	channels[0].inflight.Add(2)
+	channels[1].inflight.Add(1)
+	require.Equal(t, channels[2], leastLoaded(channels))
+	channels[2].inflight.Add(1)
+	require.Equal(t, channels[1], leastLoaded(channels))
+	require.Len(t, newChannels(0), 1)
+}
+
+func TestConnChannels(t *testing.T) {
+	t.Run("Shared", func(t *testing.T) {
+		c := newConn(endpoint.New("127.0.0.1:2135"), testConfig{})
+		require.Len(t, c.channels(), 1)
+		require.Equal(t, c.channel(false), c.channel(true))
+	})
+	t.Run("Separated", func(t *testing.T) {
+		c := newConn(endpoint.New("127.0.0.1:2135"), testConfig{channels: 2, streamChannels: 2})
+		require.Len(t, c.channels(), 4)
+		require.Contains(t, c.unary, c.channel(false))
+		require.Contains(t, c.streaming, c.channel(true))
+	})
+}
+
+func TestConnParkChannels(t *testing.T) {
+	ctx := context.Background()
+	c := newConn(endpoint.New("127.0.0.1:2135"), testConfig{channels: 2})
+	defer func() {
+		require.NoError(t, c.Close(ctx))
+	}()
+
+	for _, ch := range c.unary {
+		_, err := c.realConn(ctx, ch)
+		require.NoError(t, err)
+	}
+	require.Equal(t, Online, c.GetState())
+
+	// second channel is used recently, so only first channel is parked
+	c.unary[0].lastUsage = time.Now().Add(-time.Hour)
+	require.NoError(t, c.park(ctx, time.Minute))
+	require.Nil(t, c.unary[0].cc)
+	require.NotNil(t, c.unary[1].cc)
+	require.Equal(t, Online, c.GetState())
+
+	// channel with inflight calls is not parked
+	c.unary[1].lastUsage = time.Now().Add(-time.Hour)
+	c.unary[1].inflight.Add(1)
+	require.NoError(t, c.park(ctx, time.Minute))
+	require.NotNil(t, c.unary[1].cc)
+
+	c.unary[1].inflight.Add(-1)
+	require.NoError(t, c.park(ctx, time.Minute))
+	require.Nil(t, c.unary[1].cc)
+	require.Equal(t, Offline, c.GetState())
+}
+
+func TestConnDialBanned(t *testing.T) {
+	ctx := context.Background()
+	c := newConn(endpoint.New("127.0.0.1:2135"), testConfig{channels: 2})
+	defer func() {
+		require.NoError(t, c.Close(ctx))
+	}()
+
+	_, err := c.realConn(ctx, c.unary[0])
+	require.NoError(t, err)
+	require.Equal(t, Online, c.GetState())
+
+	c.SetState(ctx, Banned)
+	_, err = c.realConn(ctx, c.unary[1])
+	require.NoError(t, err)
+	require.Equal(t, Banned, c.GetState())
+}
+
+type testClientStream struct {
+	grpc.ClientStream
+
+	ctx context.Context //nolint:containedctx
+}
+
+func (s *testClientStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testClientStream) RecvMsg(m interface{}) error {
+	return io.EOF
+}
+
+func (s *testClientStream) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+func TestClientStreamFinish(t *testing.T) {
+	c := newConn(endpoint.New("127.0.0.1:2135"), testConfig{})
+	ch := c.channel(true)
+	newStream := func(ctx context.Context) *grpcClientStream {
+		ch.inflight.Add(1)
+
+		return &grpcClientStream{
+			ClientStream: &testClientStream{ctx: ctx},
+			c:            c,
+			ch:           ch,
+			onDone:       func(ctx context.Context, md metadata.MD) {},
+			recv: func(error) func(error, trace.ConnState, map[string][]string) {
+				return func(error, trace.ConnState, map[string][]string) {}
+			},
+		}
+	}
+
+	t.Run("RecvMsg", func(t *testing.T) {
+		s := newStream(context.Background())
+		require.Equal(t, int64(1), ch.inflight.Load())
+		require.ErrorIs(t, s.RecvMsg(nil), io.EOF)
+		require.Equal(t, int64(0), ch.inflight.Load())
+		// next errors of stream and close not releases channel twice
+		require.ErrorIs(t, s.RecvMsg(nil), io.EOF)
+		s.finish()
+		require.Equal(t, int64(0), ch.inflight.Load())
+	})
+	t.Run("Close", func(t *testing.T) {
+		s := newStream(context.Background())
+		require.Equal(t, int64(1), ch.inflight.Load())
+		s.finish()
+		require.Equal(t, int64(0), ch.inflight.Load())
+	})
+	t.Run("ContextCancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		s := newStream(ctx)
+		s.stop = context.AfterFunc(ctx, s.release)
+		cancel()
+		require.Eventually(t, func() bool {
+			return ch.inflight.Load() == 0
+		}, time.Second, time.Millisecond)
+		s.finish()
+		require.Equal(t, int64(0), ch.inflight.Load())
+	})
+	t.Run("StopAfterFinish", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s := newStream(ctx)
+		s.stop = context.AfterFunc(ctx, s.release)
+		require.ErrorIs(t, s.RecvMsg(nil), io.EOF)
+		require.Equal(t, int64(0), ch.inflight.Load())
+		// callback on cancel of context is unregistered by finish
+		require.False(t, s.stop())
+	})
+}
@@ -13,4 +13,11 @@ type Config interface {
 	Trace() *trace.Driver
 	ConnectionTTL() time.Duration
 	GrpcDialOptions() []grpc.DialOption
+
+	// ChannelsPerEndpoint is a count of grpc connections to each endpoint for unary calls
+	ChannelsPerEndpoint() int
+
+	// StreamingChannelsPerEndpoint is a count of grpc connections to each endpoint for streaming calls.
+	// If zero - streaming calls share channels with unary calls
+	StreamingChannelsPerEndpoint() int
 }
@@ -50,12 +50,12 @@ type Conn interface {
 type conn struct {
 	mtx               sync.RWMutex
 	config            Config // ro access
-	cc                *grpc.ClientConn
+	unary             []*channel
+	streaming         []*channel // nil if streaming calls share unary channels
 	done              chan struct{}
 	endpoint          endpoint.Endpoint // ro access
 	closed            bool
 	state             atomic.Uint32
-	lastUsage         time.Time
 	onClose           []func(*conn)
 	onTransportErrors []func(ctx context.Context, cc Conn, cause error)
 }
@@ -64,8 +64,22 @@ func (c *conn) Address() string {
 	return c.endpoint.Address()
 }
 
+// channels returns all channels of connection
+func (c *conn) channels() []*channel {
+	return append(append(make([]*channel, 0, len(c.unary)+len(c.streaming)), c.unary...), c.streaming...)
+}
+
+// channel returns least loaded channel for unary or streaming call
+func (c *conn) channel(streaming bool) *channel {
+	if streaming && len(c.streaming) > 0 {
+		return leastLoaded(c.streaming)
+	}
+
+	return leastLoaded(c.unary)
+}
+
 func (c *conn) Ping(ctx context.Context) error {
-	cc, err := c.realConn(ctx)
+	cc, err := c.realConn(ctx, c.channel(false))
 	if err != nil {
 		return c.wrapError(err)
 	}
@@ -76,11 +90,17 @@ func (c *conn) Ping(ctx context.Context) error {
 	return nil
 }
 
-func (c *conn) LastUsage() time.Time {
+func (c *conn) LastUsage() (lastUsage time.Time) {
 	c.mtx.RLock()
 	defer c.mtx.RUnlock()
 
-	return c.lastUsage
+	for _, ch := range c.channels() {
+		if ch.lastUsage.After(lastUsage) {
+			lastUsage = ch.lastUsage
+		}
+	}
+
+	return lastUsage
 }
 
 func (c *conn) IsState(states ...State) bool {
@@ -94,16 +114,8 @@ func (c *conn) IsState(states ...State) bool {
 	return false
 }
 
-func (c *conn) park(ctx context.Context) (err error) {
-	onDone := trace.DriverOnConnPark(
-		c.config.Trace(), &ctx,
-		stack.FunctionID(""),
-		c.Endpoint(),
-	)
-	defer func() {
-		onDone(err)
-	}()
-
+// park closes channels, which are idle longer than ttl. Connection becomes offline if all channels are parked
+func (c *conn) park(ctx context.Context, ttl time.Duration) (err error) {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 
@@ -111,19 +123,60 @@ func (c *conn) park(ctx context.Context) (err error) {
 		return nil
 	}
 
-	if c.cc == nil {
-		return nil
+	var (
+		now    = time.Now()
+		parked bool
+		issues []error
+	)
+	for _, ch := range c.channels() {
+		if ch.cc == nil || ch.inflight.Load() > 0 || now.Sub(ch.lastUsage) <= ttl {
+			continue
+		}
+		parked = true
+		if err := c.parkChannel(ctx, ch); err != nil {
+			issues = append(issues, err)
+		}
 	}
 
-	err = c.close(ctx)
+	if parked && !c.hasChannels() {
+		c.setState(ctx, Offline)
+	}
 
-	if err != nil {
-		return c.wrapError(err)
+	if len(issues) > 0 {
+		return c.wrapError(xerrors.NewWithIssues("park channels failed", issues...))
 	}
 
 	return nil
 }
 
+// conn must be locked.
+func (c *conn) parkChannel(ctx context.Context, ch *channel) (err error) {
+	onDone := trace.DriverOnConnPark(
+		c.config.Trace(), &ctx,
+		stack.FunctionID(""),
+		c.Endpoint(),
+	)
+	defer func() {
+		onDone(err)
+	}()
+
+	err = ch.cc.Close()
+	ch.cc = nil
+
+	return err
+}
+
+// hasChannels checks connection has at least one dialed channel. conn must be locked.
+func (c *conn) hasChannels() bool {
+	for _, ch := range c.channels() {
+		if ch.cc != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c *conn) NodeID() uint32 {
 	if c != nil {
 		return c.endpoint.NodeID()
@@ -156,16 +209,35 @@ func (c *conn) setState(ctx context.Context, s State) State {
 	return s
 }
 
+func (c *conn) setOnlineUnlessBanned(ctx context.Context) {
+	for {
+		state := State(c.state.Load())
+		if state == Banned || state == Online {
+			return
+		}
+		if c.state.CompareAndSwap(uint32(state), uint32(Online)) {
+			trace.DriverOnConnStateChange(
+				c.config.Trace(), &ctx,
+				stack.FunctionID(""),
+				c.endpoint.Copy(), state,
+			)(Online)
+
+			return
+		}
+	}
+}
+
 func (c *conn) Unban(ctx context.Context) State {
-	var newState State
+	newState := Offline
 	c.mtx.RLock()
-	cc := c.cc
-	c.mtx.RUnlock()
-	if isAvailable(cc) {
-		newState = Online
-	} else {
-		newState = Offline
+	for _, ch := range c.channels() {
+		if isAvailable(ch.cc) {
+			newState = Online
+
+			break
+		}
 	}
+	c.mtx.RUnlock()
 
 	c.setState(ctx, newState)
 
@@ -176,7 +248,7 @@ func (c *conn) GetState() (s State) {
 	return State(c.state.Load())
 }
 
-func (c *conn) realConn(ctx context.Context) (cc *grpc.ClientConn, err error) {
+func (c *conn) realConn(ctx context.Context, ch *channel) (cc *grpc.ClientConn, err error) {
 	if c.isClosed() {
 		return nil, c.wrapError(errClosedConnection)
 	}
@@ -184,8 +256,8 @@ func (c *conn) realConn(ctx context.Context) (cc *grpc.ClientConn, err error) {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 
-	if c.cc != nil {
-		return c.cc, nil
+	if ch.cc != nil {
+		return ch.cc, nil
 	}
 
 	if dialTimeout := c.config.DialTimeout(); dialTimeout > 0 {
@@ -228,10 +300,12 @@ func (c *conn) realConn(ctx context.Context) (cc *grpc.ClientConn, err error) {
 		)
 	}
 
-	c.cc = cc
-	c.setState(ctx, Online)
+	ch.cc = cc
+	ch.lastUsage = time.Now()
+	// dial of next channel of banned conn not unbans conn
+	c.setOnlineUnlessBanned(ctx)
 
-	return c.cc, nil
+	return cc, nil
 }
 
 func (c *conn) onTransportError(ctx context.Context, cause error) {
@@ -240,10 +314,10 @@ func (c *conn) onTransportError(ctx context.Context, cause error) {
 	}
 }
 
-func (c *conn) touchLastUsage() {
+func (c *conn) touchLastUsage(ch *channel) {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
-	c.lastUsage = time.Now()
+	ch.lastUsage = time.Now()
 }
 
 func isAvailable(raw *grpc.ClientConn) bool {
@@ -252,14 +326,26 @@ func isAvailable(raw *grpc.ClientConn) bool {
 
 // conn must be locked.
 func (c *conn) close(ctx context.Context) (err error) {
-	if c.cc == nil {
+	if !c.hasChannels() {
 		return nil
 	}
-	err = c.cc.Close()
-	c.cc = nil
+	var issues []error
+	for _, ch := range c.channels() {
+		if ch.cc == nil {
+			continue
+		}
+		if err := ch.cc.Close(); err != nil {
+			issues = append(issues, err)
+		}
+		ch.cc = nil
+	}
 	c.setState(ctx, Offline)
 
-	return c.wrapError(err)
+	if len(issues) > 0 {
+		return c.wrapError(xerrors.NewWithIssues("close channels failed", issues...))
+	}
+
+	return nil
 }
 
 func (c *conn) isClosed() bool {
@@ -316,6 +402,7 @@ func (c *conn) Invoke(
 			c.endpoint, trace.Method(method),
 		)
 		cc *grpc.ClientConn
+		ch = c.channel(false)
 		md = metadata.MD{}
 	)
 	defer func() {
@@ -323,13 +410,16 @@ func (c *conn) Invoke(
 		onDone(err, issues, opID, c.GetState(), md)
 	}()
 
-	cc, err = c.realConn(ctx)
+	ch.inflight.Add(1)
+	defer ch.inflight.Add(-1)
+
+	cc, err = c.realConn(ctx, ch)
 	if err != nil {
 		return c.wrapError(err)
 	}
 
-	c.touchLastUsage()
-	defer c.touchLastUsage()
+	c.touchLastUsage(ch)
+	defer c.touchLastUsage(ch)
 
 	ctx, traceID, err := meta.TraceID(ctx)
 	if err != nil {
@@ -398,6 +488,7 @@ func (c *conn) NewStream(
 		)
 		useWrapping = UseWrapping(ctx)
 		cc          *grpc.ClientConn
+		ch          = c.channel(true)
 		s           grpc.ClientStream
 	)
 
@@ -416,13 +507,22 @@ func (c *conn) NewStream(
 		}
 	}()
 
-	cc, err = c.realConn(ctx)
+	// stream is inflight call of channel until stream is finished
+	ch.inflight.Add(1)
+
+	defer func() {
+		if err != nil {
+			ch.inflight.Add(-1)
+		}
+	}()
+
+	cc, err = c.realConn(ctx, ch)
 	if err != nil {
 		return nil, c.wrapError(err)
 	}
 
-	c.touchLastUsage()
-	defer c.touchLastUsage()
+	c.touchLastUsage(ch)
+	defer c.touchLastUsage(ch)
 
 	ctx, traceID, err := meta.TraceID(ctx)
 	if err != nil {
@@ -452,9 +552,10 @@ func (c *conn) NewStream(
 		return s, err
 	}
 
-	return &grpcClientStream{
+	stream := &grpcClientStream{
 		ClientStream: s,
 		c:            c,
+		ch:           ch,
 		wrapping:     useWrapping,
 		traceID:      traceID,
 		sentMark:     sentMark,
@@ -463,7 +564,12 @@ func (c *conn) NewStream(
 			meta.CallTrailerCallback(ctx, md)
 		},
 		recv: streamRecv,
-	}, nil
+	}
+
+	// stream closed by cancel of context without receiving of final error is finished too
+	stream.stop = context.AfterFunc(ctx, stream.release)
+
+	return stream, nil
 }
 
 func (c *conn) wrapError(err error) error {
@@ -497,8 +603,12 @@ func newConn(e endpoint.Endpoint, config Config, opts ...option) *conn {
 	c := &conn{
 		endpoint: e,
 		config:   config,
+		unary:    newChannels(config.ChannelsPerEndpoint()),
 		done:     make(chan struct{}),
 	}
+	if n := config.StreamingChannelsPerEndpoint(); n > 0 {
+		c.streaming = newChannels(n)
+	}
 	c.state.Store(uint32(Created))
 	for _, o := range opts {
 		if o != nil {
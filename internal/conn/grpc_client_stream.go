@@ -3,6 +3,7 @@ package conn
 import (
 	"context"
 	"io"
+	"sync"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
@@ -18,11 +19,31 @@ import (
 type grpcClientStream struct {
 	grpc.ClientStream
 	c        *conn
+	ch       *channel
 	wrapping bool
 	traceID  string
 	sentMark *modificationMark
 	onDone   func(ctx context.Context, md metadata.MD)
 	recv     func(error) func(error, trace.ConnState, map[string][]string)
+
+	finishOnce sync.Once
+	// stop unregisters release of stream on cancel of stream context
+	stop func() bool
+}
+
+// finish releases inflight call of channel, finish is called once on first error of RecvMsg
+// (include io.EOF) or on close of stream
+func (s *grpcClientStream) finish() {
+	s.release()
+	if s.stop != nil {
+		s.stop()
+	}
+}
+
+func (s *grpcClientStream) release() {
+	s.finishOnce.Do(func() {
+		s.ch.inflight.Add(-1)
+	})
 }
 
 func (s *grpcClientStream) CloseSend() (err error) {
@@ -46,7 +67,7 @@ func (s *grpcClientStream) CloseSend() (err error) {
 }
 
 func (s *grpcClientStream) SendMsg(m interface{}) (err error) {
-	cancel := createPinger(s.c)
+	cancel := createPinger(s.c, s.ch)
 	defer cancel()
 
 	err = s.ClientStream.SendMsg(m)
@@ -77,12 +98,13 @@ func (s *grpcClientStream) SendMsg(m interface{}) (err error) {
 }
 
 func (s *grpcClientStream) RecvMsg(m interface{}) (err error) {
-	cancel := createPinger(s.c)
+	cancel := createPinger(s.c, s.ch)
 	defer cancel()
 
 	defer func() {
 		onDone := s.recv(xerrors.HideEOF(err))
 		if err != nil {
+			s.finish()
 			md := s.ClientStream.Trailer()
 			onDone(xerrors.HideEOF(err), s.c.GetState(), md)
 			s.onDone(s.ClientStream.Context(), md)
@@ -141,8 +163,8 @@ func (s *grpcClientStream) wrapError(err error) error {
 	)
 }
 
-func createPinger(c *conn) context.CancelFunc {
-	c.touchLastUsage()
+func createPinger(c *conn, ch *channel) context.CancelFunc {
+	c.touchLastUsage(ch)
 	ctx, cancel := xcontext.WithCancel(context.Background())
 	go func() {
 		ticker := time.NewTicker(time.Second)
@@ -154,7 +176,7 @@ func createPinger(c *conn) context.CancelFunc {
 
 				return
 			case <-ticker.C:
-				c.touchLastUsage()
+				c.touchLastUsage(ch)
 			}
 		}
 	}()
@@ -182,13 +182,11 @@ func (p *Pool) connParker(ctx context.Context, ttl, interval time.Duration) {
 			return
 		case <-ticker.C:
 			for _, c := range p.collectConns() {
-				if time.Since(c.LastUsage()) > ttl {
-					switch c.GetState() {
-					case Online, Banned:
-						_ = c.park(ctx)
-					default:
-						// nop
-					}
+				switch c.GetState() {
+				case Online, Banned:
+					_ = c.park(ctx, ttl)
+				default:
+					// nop
 				}
 			}
 		}
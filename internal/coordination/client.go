@@ -3,17 +3,21 @@ package coordination
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Coordination_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
 	"google.golang.org/grpc"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 //nolint:gofumpt
@@ -25,12 +29,16 @@ var (
 type Client struct {
 	config  config.Config
 	service Ydb_Coordination_V1.CoordinationServiceClient
+
+	sessionsMutex sync.Mutex
+	sessions      map[*session]struct{}
 }
 
 func New(ctx context.Context, cc grpc.ClientConnInterface, config config.Config) (*Client, error) {
 	return &Client{
-		config:  config,
-		service: Ydb_Coordination_V1.NewCoordinationServiceClient(cc),
+		config:   config,
+		service:  Ydb_Coordination_V1.NewCoordinationServiceClient(cc),
+		sessions: make(map[*session]struct{}),
 	}, nil
 }
 
@@ -229,6 +237,42 @@ func (c *Client) describeNode(
 	}, nil
 }
 
+func (c *Client) Session(
+	ctx context.Context,
+	path string,
+	opts ...options.SessionOption,
+) (_ coordination.Session, finalErr error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+
+	var sessionID uint64
+	onDone := trace.CoordinationOnSession(c.config.Trace(), &ctx, stack.FunctionID(""), path)
+	defer func() {
+		onDone(sessionID, finalErr)
+	}()
+
+	s, err := newSession(ctx, c, path, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	sessionID = s.SessionID()
+
+	c.sessionsMutex.Lock()
+	defer c.sessionsMutex.Unlock()
+
+	c.sessions[s] = struct{}{}
+
+	return s, nil
+}
+
+func (c *Client) removeSession(s *session) {
+	c.sessionsMutex.Lock()
+	defer c.sessionsMutex.Unlock()
+
+	delete(c.sessions, s)
+}
+
 func (c *Client) Close(ctx context.Context) error {
 	if c == nil {
 		return xerrors.WithStackTrace(errNilClient)
@@ -237,7 +281,18 @@ func (c *Client) Close(ctx context.Context) error {
 	return c.close(ctx)
 }
 
-func (c *Client) close(context.Context) error {
+func (c *Client) close(ctx context.Context) error {
+	c.sessionsMutex.Lock()
+	sessions := make([]*session, 0, len(c.sessions))
+	for s := range c.sessions {
+		sessions = append(sessions, s)
+	}
+	c.sessionsMutex.Unlock()
+
+	for _, s := range sessions {
+		_ = s.Close(ctx)
+	}
+
 	return nil
 }
 
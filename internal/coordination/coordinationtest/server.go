@@ -0,0 +1,478 @@
+// Package coordinationtest contains in-memory coordination service for tests of coordination clients and recipes
+package coordinationtest
+
+import (
+	"context"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+)
+
+// SessionOptions returns session options with short timeouts for tests
+func SessionOptions() []options.SessionOption {
+	return []options.SessionOption{
+		options.WithSessionTimeout(time.Second),
+		options.WithSessionReconnectDelay(10 * time.Millisecond),
+	}
+}
+
+type serverSemaphore struct {
+	limit     uint64
+	count     uint64
+	data      []byte
+	ephemeral bool
+	owners    map[uint64]*semaphoreOwner
+	waiters   []*semaphoreWaiter
+}
+
+type semaphoreOwner struct {
+	count uint64
+	data  []byte
+}
+
+type semaphoreWaiter struct {
+	sessionID uint64
+	stream    *sessionStream
+	reqID     uint64
+	count     uint64
+	data      []byte
+}
+
+type semaphoreWatch struct {
+	stream *sessionStream
+	reqID  uint64
+}
+
+// Server is simplified in-memory coordination service for tests, acquire timeouts except zero are not supported.
+// Server implements grpc.ClientConnInterface, so it can be used as connection of coordination client
+type Server struct {
+	mutex         sync.Mutex
+	lastSessionID uint64
+	expired       bool
+	starts        []*Ydb_Coordination.SessionRequest_SessionStart
+	streams       []*sessionStream
+	semaphores    map[string]*serverSemaphore
+	watches       map[string][]semaphoreWatch
+}
+
+// NewServer creates empty coordination service
+func NewServer() *Server {
+	return &Server{
+		semaphores: make(map[string]*serverSemaphore),
+		watches:    make(map[string][]semaphoreWatch),
+	}
+}
+
+// Invoke implements grpc.ClientConnInterface, unary calls are not supported
+func (s *Server) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	return status.Errorf(codes.Unimplemented, "method %s not implemented", method)
+}
+
+// NewStream implements grpc.ClientConnInterface, only session stream is supported
+func (s *Server) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	if desc.StreamName != "Session" {
+		return nil, status.Errorf(codes.Unimplemented, "method %s not implemented", method)
+	}
+
+	stream := &sessionStream{
+		ctx:       ctx,
+		server:    s,
+		responses: make(chan *Ydb_Coordination.SessionResponse, 100),
+		broken:    make(chan struct{}),
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.streams = append(s.streams, stream)
+
+	return stream, nil
+}
+
+// BreakStreams breaks all opened session streams, clients must reconnect
+func (s *Server) BreakStreams() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, stream := range s.streams {
+		stream.breakOnce.Do(func() {
+			close(stream.broken)
+		})
+	}
+	s.streams = nil
+}
+
+// ExpireSessions makes restore of sessions failed with SESSION_EXPIRED
+func (s *Server) ExpireSessions() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.expired = true
+}
+
+// SessionStarts returns all received session start requests
+func (s *Server) SessionStarts() []*Ydb_Coordination.SessionRequest_SessionStart {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]*Ydb_Coordination.SessionRequest_SessionStart(nil), s.starts...)
+}
+
+//nolint:funlen
+func (s *Server) handle(stream *sessionStream, request *Ydb_Coordination.SessionRequest) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch r := request.GetRequest().(type) {
+	case *Ydb_Coordination.SessionRequest_SessionStart_:
+		s.starts = append(s.starts, r.SessionStart)
+		if r.SessionStart.GetSessionId() != 0 && s.expired {
+			stream.respond(&Ydb_Coordination.SessionResponse{
+				Response: &Ydb_Coordination.SessionResponse_Failure_{
+					Failure: &Ydb_Coordination.SessionResponse_Failure{Status: Ydb.StatusIds_SESSION_EXPIRED},
+				},
+			})
+
+			return
+		}
+		stream.sessionID = r.SessionStart.GetSessionId()
+		if stream.sessionID == 0 {
+			s.lastSessionID++
+			stream.sessionID = s.lastSessionID
+		}
+		stream.respond(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_SessionStarted_{
+				SessionStarted: &Ydb_Coordination.SessionResponse_SessionStarted{
+					SessionId:     stream.sessionID,
+					TimeoutMillis: r.SessionStart.GetTimeoutMillis(),
+				},
+			},
+		})
+	case *Ydb_Coordination.SessionRequest_SessionStop_:
+		for name := range s.semaphores {
+			s.release(name, stream.sessionID)
+		}
+		stream.respond(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_SessionStopped_{
+				SessionStopped: &Ydb_Coordination.SessionResponse_SessionStopped{SessionId: stream.sessionID},
+			},
+		})
+	case *Ydb_Coordination.SessionRequest_Ping:
+		stream.respond(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_Pong{
+				Pong: &Ydb_Coordination.SessionResponse_PingPong{Opaque: r.Ping.GetOpaque()},
+			},
+		})
+	case *Ydb_Coordination.SessionRequest_CreateSemaphore_:
+		status := Ydb.StatusIds_SUCCESS
+		if _, has := s.semaphores[r.CreateSemaphore.GetName()]; has {
+			status = Ydb.StatusIds_ALREADY_EXISTS
+		} else {
+			s.semaphores[r.CreateSemaphore.GetName()] = &serverSemaphore{
+				limit:  r.CreateSemaphore.GetLimit(),
+				data:   r.CreateSemaphore.GetData(),
+				owners: make(map[uint64]*semaphoreOwner),
+			}
+		}
+		stream.respond(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_CreateSemaphoreResult_{
+				CreateSemaphoreResult: &Ydb_Coordination.SessionResponse_CreateSemaphoreResult{
+					ReqId:  r.CreateSemaphore.GetReqId(),
+					Status: status,
+				},
+			},
+		})
+	case *Ydb_Coordination.SessionRequest_UpdateSemaphore_:
+		status := Ydb.StatusIds_SUCCESS
+		if semaphore, has := s.semaphores[r.UpdateSemaphore.GetName()]; has {
+			semaphore.data = r.UpdateSemaphore.GetData()
+			s.notifyWatches(r.UpdateSemaphore.GetName())
+		} else {
+			status = Ydb.StatusIds_NOT_FOUND
+		}
+		stream.respond(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_UpdateSemaphoreResult_{
+				UpdateSemaphoreResult: &Ydb_Coordination.SessionResponse_UpdateSemaphoreResult{
+					ReqId:  r.UpdateSemaphore.GetReqId(),
+					Status: status,
+				},
+			},
+		})
+	case *Ydb_Coordination.SessionRequest_AcquireSemaphore_:
+		s.acquire(stream, r.AcquireSemaphore)
+	case *Ydb_Coordination.SessionRequest_ReleaseSemaphore_:
+		stream.respond(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_ReleaseSemaphoreResult_{
+				ReleaseSemaphoreResult: &Ydb_Coordination.SessionResponse_ReleaseSemaphoreResult{
+					ReqId:    r.ReleaseSemaphore.GetReqId(),
+					Status:   Ydb.StatusIds_SUCCESS,
+					Released: s.release(r.ReleaseSemaphore.GetName(), stream.sessionID),
+				},
+			},
+		})
+	case *Ydb_Coordination.SessionRequest_DescribeSemaphore_:
+		result := &Ydb_Coordination.SessionResponse_DescribeSemaphoreResult{
+			ReqId:  r.DescribeSemaphore.GetReqId(),
+			Status: Ydb.StatusIds_SUCCESS,
+		}
+		if semaphore, has := s.semaphores[r.DescribeSemaphore.GetName()]; has {
+			result.SemaphoreDescription = &Ydb_Coordination.SemaphoreDescription{
+				Name:      r.DescribeSemaphore.GetName(),
+				Data:      semaphore.data,
+				Count:     semaphore.count,
+				Limit:     semaphore.limit,
+				Ephemeral: semaphore.ephemeral,
+			}
+			if r.DescribeSemaphore.GetIncludeOwners() {
+				for sessionID, owner := range semaphore.owners {
+					result.SemaphoreDescription.Owners = append(result.SemaphoreDescription.Owners,
+						&Ydb_Coordination.SemaphoreSession{SessionId: sessionID, Count: owner.count, Data: owner.data},
+					)
+				}
+			}
+			if r.DescribeSemaphore.GetIncludeWaiters() {
+				for _, waiter := range semaphore.waiters {
+					result.SemaphoreDescription.Waiters = append(result.SemaphoreDescription.Waiters,
+						&Ydb_Coordination.SemaphoreSession{
+							SessionId: waiter.sessionID,
+							Count:     waiter.count,
+							Data:      waiter.data,
+						},
+					)
+				}
+			}
+			if r.DescribeSemaphore.GetWatchData() || r.DescribeSemaphore.GetWatchOwners() {
+				result.WatchAdded = true
+				s.watches[r.DescribeSemaphore.GetName()] = append(s.watches[r.DescribeSemaphore.GetName()],
+					semaphoreWatch{stream: stream, reqID: r.DescribeSemaphore.GetReqId()},
+				)
+			}
+		} else {
+			result.Status = Ydb.StatusIds_NOT_FOUND
+		}
+		stream.respond(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_DescribeSemaphoreResult_{
+				DescribeSemaphoreResult: result,
+			},
+		})
+	}
+}
+
+func (s *Server) acquire(
+	stream *sessionStream,
+	request *Ydb_Coordination.SessionRequest_AcquireSemaphore,
+) {
+	respond := func(stream *sessionStream, reqID uint64, status Ydb.StatusIds_StatusCode, acquired bool) {
+		stream.respond(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult_{
+				AcquireSemaphoreResult: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult{
+					ReqId:    reqID,
+					Status:   status,
+					Acquired: acquired,
+				},
+			},
+		})
+	}
+
+	semaphore, has := s.semaphores[request.GetName()]
+	if !has {
+		if !request.GetEphemeral() {
+			respond(stream, request.GetReqId(), Ydb.StatusIds_NOT_FOUND, false)
+
+			return
+		}
+		semaphore = &serverSemaphore{
+			limit:     math.MaxUint64,
+			ephemeral: true,
+			owners:    make(map[uint64]*semaphoreOwner),
+		}
+		s.semaphores[request.GetName()] = semaphore
+	}
+
+	waiter := &semaphoreWaiter{
+		sessionID: stream.sessionID,
+		stream:    stream,
+		reqID:     request.GetReqId(),
+		count:     request.GetCount(),
+		data:      request.GetData(),
+	}
+	for i, w := range semaphore.waiters {
+		if w.sessionID == waiter.sessionID {
+			// repeated request after reconnect
+			semaphore.waiters[i] = waiter
+
+			return
+		}
+	}
+	if len(semaphore.waiters) == 0 && semaphore.fits(waiter) {
+		semaphore.own(waiter)
+		respond(stream, request.GetReqId(), Ydb.StatusIds_SUCCESS, true)
+		s.notifyWatches(request.GetName())
+
+		return
+	}
+	if request.GetTimeoutMillis() == 0 {
+		respond(stream, request.GetReqId(), Ydb.StatusIds_SUCCESS, false)
+
+		return
+	}
+	semaphore.waiters = append(semaphore.waiters, waiter)
+	s.notifyWatches(request.GetName())
+}
+
+func (s *Server) release(name string, sessionID uint64) (released bool) {
+	semaphore, has := s.semaphores[name]
+	if !has {
+		return false
+	}
+	if owner, has := semaphore.owners[sessionID]; has {
+		released = true
+		semaphore.count -= owner.count
+		delete(semaphore.owners, sessionID)
+	}
+	for i, waiter := range semaphore.waiters {
+		if waiter.sessionID == sessionID {
+			released = true
+			semaphore.waiters = append(semaphore.waiters[:i], semaphore.waiters[i+1:]...)
+			waiter.stream.respond(&Ydb_Coordination.SessionResponse{
+				Response: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult_{
+					AcquireSemaphoreResult: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult{
+						ReqId:  waiter.reqID,
+						Status: Ydb.StatusIds_ABORTED,
+					},
+				},
+			})
+
+			break
+		}
+	}
+	if !released {
+		return false
+	}
+	for len(semaphore.waiters) > 0 && semaphore.fits(semaphore.waiters[0]) {
+		waiter := semaphore.waiters[0]
+		semaphore.waiters = semaphore.waiters[1:]
+		semaphore.own(waiter)
+		waiter.stream.respond(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult_{
+				AcquireSemaphoreResult: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult{
+					ReqId:    waiter.reqID,
+					Status:   Ydb.StatusIds_SUCCESS,
+					Acquired: true,
+				},
+			},
+		})
+	}
+	if semaphore.ephemeral && len(semaphore.owners) == 0 && len(semaphore.waiters) == 0 {
+		delete(s.semaphores, name)
+	}
+	s.notifyWatches(name)
+
+	return true
+}
+
+func (s *serverSemaphore) fits(waiter *semaphoreWaiter) bool {
+	var owned uint64
+	if owner, has := s.owners[waiter.sessionID]; has {
+		owned = owner.count
+	}
+
+	return waiter.count <= s.limit && s.count-owned <= s.limit-waiter.count
+}
+
+func (s *serverSemaphore) own(waiter *semaphoreWaiter) {
+	if owner, has := s.owners[waiter.sessionID]; has {
+		s.count -= owner.count
+	}
+	s.count += waiter.count
+	s.owners[waiter.sessionID] = &semaphoreOwner{count: waiter.count, data: waiter.data}
+}
+
+func (s *Server) notifyWatches(name string) {
+	for _, watch := range s.watches[name] {
+		watch.stream.respond(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged_{
+				DescribeSemaphoreChanged: &Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged{
+					ReqId:         watch.reqID,
+					DataChanged:   true,
+					OwnersChanged: true,
+				},
+			},
+		})
+	}
+	delete(s.watches, name)
+}
+
+type sessionStream struct {
+	grpc.ClientStream
+
+	ctx       context.Context
+	server    *Server
+	responses chan *Ydb_Coordination.SessionResponse
+	broken    chan struct{}
+	breakOnce sync.Once
+	sessionID uint64
+}
+
+func (s *sessionStream) respond(response *Ydb_Coordination.SessionResponse) {
+	s.responses <- response
+}
+
+func (s *sessionStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sessionStream) CloseSend() error {
+	return nil
+}
+
+func (s *sessionStream) SendMsg(m any) error {
+	request, ok := m.(*Ydb_Coordination.SessionRequest)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected message type %T", m)
+	}
+
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case <-s.broken:
+		return io.EOF
+	default:
+		s.server.handle(s, request)
+
+		return nil
+	}
+}
+
+func (s *sessionStream) RecvMsg(m any) error {
+	message, ok := m.(*Ydb_Coordination.SessionResponse)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected message type %T", m)
+	}
+
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case <-s.broken:
+		return io.EOF
+	case response := <-s.responses:
+		proto.Merge(message, response)
+
+		return nil
+	}
+}
@@ -0,0 +1,919 @@
+package coordination
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Coordination_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const protectionKeyLen = 16
+
+var (
+	errSessionStopped          = xerrors.Wrap(errors.New("ydb: coordination session stopped"))
+	errSessionKeepAliveTimeout = xerrors.Wrap(errors.New("ydb: coordination session keep alive timeout"))
+	errSessionReconnectTimeout = xerrors.Wrap(errors.New("ydb: coordination session reconnect timeout"))
+	errSessionStartTimeout     = xerrors.Wrap(errors.New("ydb: coordination session start timeout"))
+)
+
+var _ coordination.Session = (*session)(nil)
+
+type session struct {
+	client        *Client
+	path          string
+	options       *options.CreateSessionOptions
+	protectionKey []byte
+
+	// ctx cancelled when session closed or lost
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	sendMutex sync.Mutex // serializes sends to stream
+
+	mutex     sync.Mutex
+	sessionID uint64
+	seqNo     uint64
+	lastReqID uint64
+	requests  map[uint64]*sessionRequest
+	watches   map[uint64]chan struct{}
+	stream    Ydb_Coordination_V1.CoordinationService_SessionClient // nil while reconnecting
+	closing   bool
+}
+
+type sessionRequest struct {
+	request *Ydb_Coordination.SessionRequest
+	result  chan *Ydb_Coordination.SessionResponse
+	// changed not nil for describe requests with watch
+	changed chan struct{}
+}
+
+func newSession(
+	ctx context.Context,
+	client *Client,
+	path string,
+	opts ...options.SessionOption,
+) (*session, error) {
+	protectionKey := make([]byte, protectionKeyLen)
+	if _, err := rand.Read(protectionKey); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	sessionCtx, cancel := xcontext.WithCancel(xcontext.WithoutDeadline(ctx))
+	s := &session{
+		client:        client,
+		path:          path,
+		options:       options.NewCreateSessionOptions(opts...),
+		protectionKey: protectionKey,
+		ctx:           sessionCtx,
+		cancel:        cancel,
+		done:          make(chan struct{}),
+		requests:      make(map[uint64]*sessionRequest),
+		watches:       make(map[uint64]chan struct{}),
+	}
+
+	started := make(chan error, 1)
+	go s.mainLoop(started)
+
+	select {
+	case <-ctx.Done():
+		s.cancel()
+		<-s.done
+
+		return nil, xerrors.WithStackTrace(ctx.Err())
+	case err := <-started:
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return s, nil
+	}
+}
+
+func (s *session) Context() context.Context {
+	return s.ctx
+}
+
+func (s *session) SessionID() uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.sessionID
+}
+
+func (s *session) isClosing() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.closing
+}
+
+// mainLoop starts session and reconnects it with same session ID until session closed or lost
+func (s *session) mainLoop(started chan<- error) {
+	defer close(s.done)
+	defer s.cancel()
+
+	var (
+		isStarted    bool
+		lastGoodTime time.Time
+		err          error
+	)
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if time.Since(lastGoodTime) > s.options.SessionTimeout {
+				s.lost(xerrors.WithStackTrace(xerrors.Join(errSessionReconnectTimeout, err)))
+
+				return
+			}
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(s.options.SessionReconnectDelay):
+			}
+		}
+
+		var (
+			stream       Ydb_Coordination_V1.CoordinationService_SessionClient
+			streamCancel context.CancelFunc
+		)
+		stream, streamCancel, err = s.startStream()
+		if err != nil {
+			if !isStarted {
+				started <- err
+
+				return
+			}
+			if s.ctx.Err() != nil {
+				return
+			}
+			if isSessionLostError(err) {
+				s.lost(err)
+
+				return
+			}
+
+			continue
+		}
+
+		if !isStarted {
+			isStarted = true
+			started <- nil
+		}
+
+		lastGoodTime, err = s.serveStream(stream)
+		streamCancel()
+
+		if s.ctx.Err() != nil || s.isClosing() {
+			return
+		}
+		if isSessionLostError(err) {
+			s.lost(err)
+
+			return
+		}
+	}
+}
+
+func isSessionLostError(err error) bool {
+	return xerrors.IsOperationError(err,
+		Ydb.StatusIds_BAD_SESSION,
+		Ydb.StatusIds_SESSION_EXPIRED,
+		Ydb.StatusIds_NOT_FOUND,
+	)
+}
+
+func (s *session) lost(err error) {
+	trace.CoordinationOnSessionLost(s.client.config.Trace(), s.SessionID(), err)
+	s.cancel()
+	s.client.removeSession(s)
+}
+
+// startStream opens new stream and starts new session or reattaches existing session on it
+func (s *session) startStream() (
+	_ Ydb_Coordination_V1.CoordinationService_SessionClient,
+	_ context.CancelFunc,
+	finalErr error,
+) {
+	s.mutex.Lock()
+	sessionID := s.sessionID
+	s.seqNo++
+	seqNo := s.seqNo
+	s.mutex.Unlock()
+
+	onDone := trace.CoordinationOnSessionStart(s.client.config.Trace(), s.path, sessionID)
+	defer func() {
+		onDone(s.SessionID(), finalErr)
+	}()
+
+	streamCtx, streamCancel := xcontext.WithCancel(s.ctx)
+	defer func() {
+		if finalErr != nil {
+			streamCancel()
+		}
+	}()
+
+	stream, err := s.client.service.Session(streamCtx)
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	err = stream.Send(&Ydb_Coordination.SessionRequest{
+		Request: &Ydb_Coordination.SessionRequest_SessionStart_{
+			SessionStart: &Ydb_Coordination.SessionRequest_SessionStart{
+				Path:          s.path,
+				SessionId:     sessionID,
+				TimeoutMillis: uint64(s.options.SessionTimeout.Milliseconds()),
+				Description:   s.options.Description,
+				SeqNo:         seqNo,
+				ProtectionKey: s.protectionKey,
+			},
+		},
+	})
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	startTimeout := time.AfterFunc(s.options.SessionStartTimeout, streamCancel)
+	defer startTimeout.Stop()
+
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if !startTimeout.Stop() && s.ctx.Err() == nil {
+				return nil, nil, xerrors.WithStackTrace(xerrors.Join(errSessionStartTimeout, err))
+			}
+
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+
+		switch {
+		case response.GetPing() != nil:
+			err = s.send(stream, pongRequest(response.GetPing().GetOpaque()))
+			if err != nil {
+				return nil, nil, xerrors.WithStackTrace(err)
+			}
+		case response.GetFailure() != nil:
+			err = xerrors.Operation(xerrors.FromOperation(response.GetFailure()))
+			trace.CoordinationOnSessionServerError(s.client.config.Trace(), sessionID, err)
+
+			return nil, nil, xerrors.WithStackTrace(err)
+		case response.GetSessionStarted() != nil:
+			s.mutex.Lock()
+			s.sessionID = response.GetSessionStarted().GetSessionId()
+			s.mutex.Unlock()
+
+			return stream, streamCancel, nil
+		}
+	}
+}
+
+// serveStream handles responses and keeps session alive until stream broken.
+// It returns time of last response from server.
+func (s *session) serveStream(
+	stream Ydb_Coordination_V1.CoordinationService_SessionClient,
+) (lastGoodTime time.Time, _ error) {
+	s.mutex.Lock()
+	s.stream = stream
+	pending := make([]uint64, 0, len(s.requests))
+	for reqID := range s.requests {
+		pending = append(pending, reqID)
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i] < pending[j]
+	})
+	requests := make([]*Ydb_Coordination.SessionRequest, len(pending))
+	for i, reqID := range pending {
+		requests[i] = s.requests[reqID].request
+	}
+	// watches are not restored after reconnect, watchers must describe semaphore again
+	for reqID, changed := range s.watches {
+		close(changed)
+		delete(s.watches, reqID)
+	}
+	s.mutex.Unlock()
+
+	defer func() {
+		s.mutex.Lock()
+		s.stream = nil
+		s.mutex.Unlock()
+	}()
+
+	// resend requests, which were sent to previous streams and not answered
+	for _, request := range requests {
+		if err := s.send(stream, request); err != nil {
+			return time.Now(), xerrors.WithStackTrace(err)
+		}
+	}
+
+	var (
+		responses = make(chan *Ydb_Coordination.SessionResponse)
+		recvErr   = make(chan error, 1)
+		done      = make(chan struct{})
+	)
+	defer close(done)
+
+	go func() {
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+
+				return
+			}
+			select {
+			case responses <- response:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	keepAliveTicker := time.NewTicker(s.options.SessionKeepAliveTimeout / 4) //nolint:gomnd
+	defer keepAliveTicker.Stop()
+
+	var pingOpaque uint64
+
+	lastGoodTime = time.Now()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return lastGoodTime, xerrors.WithStackTrace(s.ctx.Err())
+		case err := <-recvErr:
+			return lastGoodTime, xerrors.WithStackTrace(err)
+		case <-keepAliveTicker.C:
+			if time.Since(lastGoodTime) > s.options.SessionKeepAliveTimeout {
+				trace.CoordinationOnSessionKeepAliveTimeout(s.client.config.Trace(),
+					s.SessionID(), lastGoodTime, s.options.SessionKeepAliveTimeout,
+				)
+
+				return lastGoodTime, xerrors.WithStackTrace(errSessionKeepAliveTimeout)
+			}
+			pingOpaque++
+			if err := s.send(stream, pingRequest(pingOpaque)); err != nil {
+				return lastGoodTime, xerrors.WithStackTrace(err)
+			}
+		case response := <-responses:
+			lastGoodTime = time.Now()
+			if err := s.handleResponse(stream, response); err != nil {
+				return lastGoodTime, xerrors.WithStackTrace(err)
+			}
+		}
+	}
+}
+
+func (s *session) handleResponse(
+	stream Ydb_Coordination_V1.CoordinationService_SessionClient,
+	response *Ydb_Coordination.SessionResponse,
+) error {
+	switch {
+	case response.GetPing() != nil:
+		return s.send(stream, pongRequest(response.GetPing().GetOpaque()))
+	case response.GetPong() != nil, response.GetAcquireSemaphorePending() != nil:
+		return nil
+	case response.GetFailure() != nil:
+		err := xerrors.Operation(xerrors.FromOperation(response.GetFailure()))
+		trace.CoordinationOnSessionServerError(s.client.config.Trace(), s.SessionID(), err)
+
+		return xerrors.WithStackTrace(err)
+	case response.GetSessionStopped() != nil:
+		return xerrors.WithStackTrace(errSessionStopped)
+	case response.GetDescribeSemaphoreChanged() != nil:
+		s.notifyWatch(response.GetDescribeSemaphoreChanged().GetReqId())
+
+		return nil
+	}
+
+	if reqID, ok := responseReqID(response); ok {
+		s.complete(reqID, response)
+	}
+
+	return nil
+}
+
+func responseReqID(response *Ydb_Coordination.SessionResponse) (uint64, bool) {
+	switch r := response.GetResponse().(type) {
+	case *Ydb_Coordination.SessionResponse_AcquireSemaphoreResult_:
+		return r.AcquireSemaphoreResult.GetReqId(), true
+	case *Ydb_Coordination.SessionResponse_ReleaseSemaphoreResult_:
+		return r.ReleaseSemaphoreResult.GetReqId(), true
+	case *Ydb_Coordination.SessionResponse_DescribeSemaphoreResult_:
+		return r.DescribeSemaphoreResult.GetReqId(), true
+	case *Ydb_Coordination.SessionResponse_CreateSemaphoreResult_:
+		return r.CreateSemaphoreResult.GetReqId(), true
+	case *Ydb_Coordination.SessionResponse_UpdateSemaphoreResult_:
+		return r.UpdateSemaphoreResult.GetReqId(), true
+	case *Ydb_Coordination.SessionResponse_DeleteSemaphoreResult_:
+		return r.DeleteSemaphoreResult.GetReqId(), true
+	default:
+		return 0, false
+	}
+}
+
+func (s *session) complete(reqID uint64, response *Ydb_Coordination.SessionResponse) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	r, has := s.requests[reqID]
+	if !has {
+		return
+	}
+	delete(s.requests, reqID)
+
+	if r.changed != nil && response.GetDescribeSemaphoreResult().GetWatchAdded() {
+		s.watches[reqID] = r.changed
+	}
+
+	r.result <- response
+}
+
+func (s *session) notifyWatch(reqID uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if changed, has := s.watches[reqID]; has {
+		close(changed)
+		delete(s.watches, reqID)
+	}
+}
+
+func (s *session) removeWatch(changed chan struct{}) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for reqID, ch := range s.watches {
+		if ch == changed {
+			delete(s.watches, reqID)
+
+			return
+		}
+	}
+}
+
+func (s *session) send(
+	stream Ydb_Coordination_V1.CoordinationService_SessionClient,
+	request *Ydb_Coordination.SessionRequest,
+) error {
+	s.sendMutex.Lock()
+	defer s.sendMutex.Unlock()
+
+	return stream.Send(request)
+}
+
+func pingRequest(opaque uint64) *Ydb_Coordination.SessionRequest {
+	return &Ydb_Coordination.SessionRequest{
+		Request: &Ydb_Coordination.SessionRequest_Ping{
+			Ping: &Ydb_Coordination.SessionRequest_PingPong{
+				Opaque: opaque,
+			},
+		},
+	}
+}
+
+func pongRequest(opaque uint64) *Ydb_Coordination.SessionRequest {
+	return &Ydb_Coordination.SessionRequest{
+		Request: &Ydb_Coordination.SessionRequest_Pong{
+			Pong: &Ydb_Coordination.SessionRequest_PingPong{
+				Opaque: opaque,
+			},
+		},
+	}
+}
+
+// call sends request with new request ID and waits result.
+// Request is resent after reconnect if it not answered on previous stream.
+func (s *session) call(
+	ctx context.Context,
+	method string,
+	semaphore string,
+	watch bool,
+	newRequest func(reqID uint64) *Ydb_Coordination.SessionRequest,
+) (_ *Ydb_Coordination.SessionResponse, changed chan struct{}, finalErr error) {
+	onDone := trace.CoordinationOnSessionRequest(s.client.config.Trace(), &ctx,
+		stack.FunctionID(""), s.SessionID(), method, semaphore,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+
+	r := &sessionRequest{
+		result: make(chan *Ydb_Coordination.SessionResponse, 1),
+	}
+	if watch {
+		r.changed = make(chan struct{})
+	}
+
+	s.mutex.Lock()
+	if s.closing || s.ctx.Err() != nil {
+		s.mutex.Unlock()
+
+		return nil, nil, xerrors.WithStackTrace(coordination.ErrSessionClosed)
+	}
+	s.lastReqID++
+	reqID := s.lastReqID
+	r.request = newRequest(reqID)
+	s.requests[reqID] = r
+	stream := s.stream
+	s.mutex.Unlock()
+
+	if stream != nil {
+		// error ignored, the request will be resent after reconnect
+		_ = s.send(stream, r.request)
+	}
+
+	select {
+	case <-ctx.Done():
+		s.mutex.Lock()
+		delete(s.requests, reqID)
+		s.mutex.Unlock()
+
+		return nil, nil, xerrors.WithStackTrace(ctx.Err())
+	case <-s.ctx.Done():
+		return nil, nil, xerrors.WithStackTrace(coordination.ErrSessionClosed)
+	case response := <-r.result:
+		return response, r.changed, nil
+	}
+}
+
+func (s *session) Close(ctx context.Context) (finalErr error) {
+	onDone := trace.CoordinationOnSessionClose(s.client.config.Trace(), &ctx, stack.FunctionID(""), s.SessionID())
+	defer func() {
+		onDone(finalErr)
+	}()
+
+	s.mutex.Lock()
+	if s.closing {
+		s.mutex.Unlock()
+
+		return xerrors.WithStackTrace(coordination.ErrSessionClosed)
+	}
+	s.closing = true
+	stream := s.stream
+	s.mutex.Unlock()
+
+	defer s.client.removeSession(s)
+
+	if stream != nil && s.ctx.Err() == nil {
+		err := s.send(stream, &Ydb_Coordination.SessionRequest{
+			Request: &Ydb_Coordination.SessionRequest_SessionStop_{
+				SessionStop: &Ydb_Coordination.SessionRequest_SessionStop{},
+			},
+		})
+		if err == nil {
+			stopTimer := time.NewTimer(s.options.SessionStopTimeout)
+			defer stopTimer.Stop()
+
+			// main loop exits after session stopped response
+			select {
+			case <-s.done:
+			case <-stopTimer.C:
+			case <-ctx.Done():
+			}
+		}
+	}
+
+	s.cancel()
+	<-s.done
+
+	return nil
+}
+
+func (s *session) CreateSemaphore(
+	ctx context.Context,
+	name string,
+	limit uint64,
+	opts ...options.CreateSemaphoreOption,
+) error {
+	response, _, err := s.call(ctx, "CreateSemaphore", name, false,
+		func(reqID uint64) *Ydb_Coordination.SessionRequest {
+			request := &Ydb_Coordination.SessionRequest_CreateSemaphore{
+				ReqId: reqID,
+				Name:  name,
+				Limit: limit,
+			}
+			for _, opt := range opts {
+				if opt != nil {
+					opt(request)
+				}
+			}
+
+			return &Ydb_Coordination.SessionRequest{
+				Request: &Ydb_Coordination.SessionRequest_CreateSemaphore_{CreateSemaphore: request},
+			}
+		},
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return resultError(response.GetCreateSemaphoreResult())
+}
+
+func (s *session) UpdateSemaphore(
+	ctx context.Context,
+	name string,
+	opts ...options.UpdateSemaphoreOption,
+) error {
+	response, _, err := s.call(ctx, "UpdateSemaphore", name, false,
+		func(reqID uint64) *Ydb_Coordination.SessionRequest {
+			request := &Ydb_Coordination.SessionRequest_UpdateSemaphore{
+				ReqId: reqID,
+				Name:  name,
+			}
+			for _, opt := range opts {
+				if opt != nil {
+					opt(request)
+				}
+			}
+
+			return &Ydb_Coordination.SessionRequest{
+				Request: &Ydb_Coordination.SessionRequest_UpdateSemaphore_{UpdateSemaphore: request},
+			}
+		},
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return resultError(response.GetUpdateSemaphoreResult())
+}
+
+func (s *session) DeleteSemaphore(
+	ctx context.Context,
+	name string,
+	opts ...options.DeleteSemaphoreOption,
+) error {
+	response, _, err := s.call(ctx, "DeleteSemaphore", name, false,
+		func(reqID uint64) *Ydb_Coordination.SessionRequest {
+			request := &Ydb_Coordination.SessionRequest_DeleteSemaphore{
+				ReqId: reqID,
+				Name:  name,
+			}
+			for _, opt := range opts {
+				if opt != nil {
+					opt(request)
+				}
+			}
+
+			return &Ydb_Coordination.SessionRequest{
+				Request: &Ydb_Coordination.SessionRequest_DeleteSemaphore_{DeleteSemaphore: request},
+			}
+		},
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return resultError(response.GetDeleteSemaphoreResult())
+}
+
+func (s *session) DescribeSemaphore(
+	ctx context.Context,
+	name string,
+	opts ...options.DescribeSemaphoreOption,
+) (*coordination.SemaphoreDescription, error) {
+	description, _, err := s.describeSemaphore(ctx, name, false, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return description, nil
+}
+
+func (s *session) describeSemaphore(
+	ctx context.Context,
+	name string,
+	watch bool,
+	opts ...options.DescribeSemaphoreOption,
+) (*coordination.SemaphoreDescription, chan struct{}, error) {
+	method := "DescribeSemaphore"
+	if watch {
+		method = "WatchSemaphore"
+	}
+	response, changed, err := s.call(ctx, method, name, watch,
+		func(reqID uint64) *Ydb_Coordination.SessionRequest {
+			request := &Ydb_Coordination.SessionRequest_DescribeSemaphore{
+				ReqId:       reqID,
+				Name:        name,
+				WatchData:   watch,
+				WatchOwners: watch,
+			}
+			for _, opt := range opts {
+				if opt != nil {
+					opt(request)
+				}
+			}
+
+			return &Ydb_Coordination.SessionRequest{
+				Request: &Ydb_Coordination.SessionRequest_DescribeSemaphore_{DescribeSemaphore: request},
+			}
+		},
+	)
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	result := response.GetDescribeSemaphoreResult()
+	if err = resultError(result); err != nil {
+		return nil, nil, err
+	}
+
+	return convertSemaphoreDescription(result.GetSemaphoreDescription()), changed, nil
+}
+
+func (s *session) WatchSemaphore(
+	ctx context.Context,
+	name string,
+	opts ...options.DescribeSemaphoreOption,
+) (<-chan *coordination.SemaphoreDescription, error) {
+	description, changed, err := s.describeSemaphore(ctx, name, true, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	descriptions := make(chan *coordination.SemaphoreDescription, 1)
+	descriptions <- description
+
+	go func() {
+		defer close(descriptions)
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.removeWatch(changed)
+
+				return
+			case <-s.ctx.Done():
+				return
+			case <-changed:
+			}
+
+			description, changed, err = s.describeSemaphore(ctx, name, true, opts...)
+			if err != nil {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				s.removeWatch(changed)
+
+				return
+			case <-s.ctx.Done():
+				return
+			case descriptions <- description:
+			}
+		}
+	}()
+
+	return descriptions, nil
+}
+
+func (s *session) AcquireSemaphore(
+	ctx context.Context,
+	name string,
+	count uint64,
+	opts ...options.AcquireSemaphoreOption,
+) (coordination.Lease, error) {
+	response, _, err := s.call(ctx, "AcquireSemaphore", name, false,
+		func(reqID uint64) *Ydb_Coordination.SessionRequest {
+			request := &Ydb_Coordination.SessionRequest_AcquireSemaphore{
+				ReqId:         reqID,
+				Name:          name,
+				Count:         count,
+				TimeoutMillis: math.MaxUint64,
+			}
+			for _, opt := range opts {
+				if opt != nil {
+					opt(request)
+				}
+			}
+
+			return &Ydb_Coordination.SessionRequest{
+				Request: &Ydb_Coordination.SessionRequest_AcquireSemaphore_{AcquireSemaphore: request},
+			}
+		},
+	)
+	if err != nil {
+		if ctx.Err() != nil && s.ctx.Err() == nil {
+			// semaphore may be acquired or may be acquired later by sent request
+			go func() {
+				_ = s.releaseSemaphore(s.ctx, name)
+			}()
+		}
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	result := response.GetAcquireSemaphoreResult()
+	if err = resultError(result); err != nil {
+		return nil, err
+	}
+	if !result.GetAcquired() {
+		return nil, xerrors.WithStackTrace(coordination.ErrAcquireTimeout)
+	}
+
+	leaseCtx, cancel := xcontext.WithCancel(s.ctx)
+
+	return &lease{
+		session: s,
+		name:    name,
+		ctx:     leaseCtx,
+		cancel:  cancel,
+	}, nil
+}
+
+func (s *session) releaseSemaphore(ctx context.Context, name string) error {
+	response, _, err := s.call(ctx, "ReleaseSemaphore", name, false,
+		func(reqID uint64) *Ydb_Coordination.SessionRequest {
+			return &Ydb_Coordination.SessionRequest{
+				Request: &Ydb_Coordination.SessionRequest_ReleaseSemaphore_{
+					ReleaseSemaphore: &Ydb_Coordination.SessionRequest_ReleaseSemaphore{
+						ReqId: reqID,
+						Name:  name,
+					},
+				},
+			}
+		},
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return resultError(response.GetReleaseSemaphoreResult())
+}
+
+func resultError(result operation.Status) error {
+	if result.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(result)))
+	}
+
+	return nil
+}
+
+func convertSemaphoreDescription(d *Ydb_Coordination.SemaphoreDescription) *coordination.SemaphoreDescription {
+	return &coordination.SemaphoreDescription{
+		Name:      d.GetName(),
+		Data:      d.GetData(),
+		Count:     d.GetCount(),
+		Limit:     d.GetLimit(),
+		Ephemeral: d.GetEphemeral(),
+		Owners:    convertSemaphoreSessions(d.GetOwners()),
+		Waiters:   convertSemaphoreSessions(d.GetWaiters()),
+	}
+}
+
+func convertSemaphoreSessions(sessions []*Ydb_Coordination.SemaphoreSession) []*coordination.SemaphoreSession {
+	if sessions == nil {
+		return nil
+	}
+
+	res := make([]*coordination.SemaphoreSession, len(sessions))
+	for i, s := range sessions {
+		res[i] = &coordination.SemaphoreSession{
+			OrderID:   s.GetOrderId(),
+			SessionID: s.GetSessionId(),
+			Timeout:   time.Duration(s.GetTimeoutMillis()) * time.Millisecond,
+			Count:     s.GetCount(),
+			Data:      s.GetData(),
+		}
+	}
+
+	return res
+}
+
+var _ coordination.Lease = (*lease)(nil)
+
+type lease struct {
+	session *session
+	name    string
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+func (l *lease) Context() context.Context {
+	return l.ctx
+}
+
+func (l *lease) Release(ctx context.Context) error {
+	defer l.cancel()
+
+	return l.session.releaseSemaphore(ctx, l.name)
+}
+
+func (l *lease) Session() coordination.Session {
+	return l.session
+}
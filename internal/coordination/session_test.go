@@ -0,0 +1,143 @@
+package coordination
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/coordinationtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func newTestClient(server *coordinationtest.Server) *Client {
+	client, _ := New(context.Background(), server, config.New())
+
+	return client
+}
+
+func TestSessionSemaphores(t *testing.T) {
+	ctx := xtest.Context(t)
+	server := coordinationtest.NewServer()
+	client := newTestClient(server)
+
+	s, err := client.Session(ctx, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, s.SessionID())
+
+	require.NoError(t, s.CreateSemaphore(ctx, "test", 1, options.WithCreateData([]byte("data"))))
+	err = s.CreateSemaphore(ctx, "test", 1)
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_ALREADY_EXISTS))
+
+	lease, err := s.AcquireSemaphore(ctx, "test", 1)
+	require.NoError(t, err)
+
+	description, err := s.DescribeSemaphore(ctx, "test", options.WithDescribeOwners(true))
+	require.NoError(t, err)
+	require.Equal(t, "test", description.Name)
+	require.Equal(t, []byte("data"), description.Data)
+	require.EqualValues(t, 1, description.Count)
+	require.Len(t, description.Owners, 1)
+	require.Equal(t, s.SessionID(), description.Owners[0].SessionID)
+
+	_, err = s.AcquireSemaphore(ctx, "test", 2, options.WithAcquireTimeout(0))
+	require.ErrorIs(t, err, coordination.ErrAcquireTimeout)
+
+	require.NoError(t, lease.Release(ctx))
+	require.Error(t, lease.Context().Err())
+
+	require.NoError(t, s.Close(ctx))
+	require.Error(t, s.Context().Err())
+	require.ErrorIs(t, s.CreateSemaphore(ctx, "test2", 1), coordination.ErrSessionClosed)
+	require.Empty(t, client.sessions)
+}
+
+func TestSessionReconnect(t *testing.T) {
+	ctx := xtest.Context(t)
+	server := coordinationtest.NewServer()
+	client := newTestClient(server)
+
+	s, err := client.Session(ctx, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	sessionID := s.SessionID()
+
+	lease, err := s.AcquireSemaphore(ctx, "test", 1, options.WithEphemeral(true))
+	require.NoError(t, err)
+
+	server.BreakStreams()
+
+	description, err := s.DescribeSemaphore(ctx, "test", options.WithDescribeOwners(true))
+	require.NoError(t, err)
+	require.Len(t, description.Owners, 1)
+	require.Equal(t, sessionID, description.Owners[0].SessionID)
+	require.Equal(t, sessionID, s.SessionID())
+	require.NoError(t, lease.Context().Err())
+
+	starts := server.SessionStarts()
+	require.Len(t, starts, 2)
+	require.Zero(t, starts[0].GetSessionId())
+	require.Equal(t, sessionID, starts[1].GetSessionId())
+	require.Equal(t, starts[0].GetProtectionKey(), starts[1].GetProtectionKey())
+	require.Less(t, starts[0].GetSeqNo(), starts[1].GetSeqNo())
+
+	require.NoError(t, s.Close(ctx))
+}
+
+func TestSessionLost(t *testing.T) {
+	ctx := xtest.Context(t)
+	server := coordinationtest.NewServer()
+	client := newTestClient(server)
+
+	s, err := client.Session(ctx, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+
+	lease, err := s.AcquireSemaphore(ctx, "test", 1, options.WithEphemeral(true))
+	require.NoError(t, err)
+
+	server.ExpireSessions()
+	server.BreakStreams()
+
+	<-s.Context().Done()
+	<-lease.Context().Done()
+	_, err = s.DescribeSemaphore(ctx, "test")
+	require.ErrorIs(t, err, coordination.ErrSessionClosed)
+}
+
+func TestSessionWatchSemaphore(t *testing.T) {
+	ctx := xtest.Context(t)
+	server := coordinationtest.NewServer()
+	client := newTestClient(server)
+
+	s, err := client.Session(ctx, "/local/node", coordinationtest.SessionOptions()...)
+	require.NoError(t, err)
+	defer func() {
+		_ = s.Close(ctx)
+	}()
+
+	require.NoError(t, s.CreateSemaphore(ctx, "test", 1, options.WithCreateData([]byte("1"))))
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	descriptions, err := s.WatchSemaphore(watchCtx, "test")
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), (<-descriptions).Data)
+
+	require.NoError(t, s.UpdateSemaphore(ctx, "test", options.WithUpdateData([]byte("2"))))
+	require.Equal(t, []byte("2"), (<-descriptions).Data)
+
+	// watch restored after reconnect
+	server.BreakStreams()
+	require.Equal(t, []byte("2"), (<-descriptions).Data)
+	require.NoError(t, s.UpdateSemaphore(ctx, "test", options.WithUpdateData([]byte("3"))))
+	require.Equal(t, []byte("3"), (<-descriptions).Data)
+
+	cancel()
+	for range descriptions {
+	}
+}
@@ -0,0 +1,97 @@
+package drain
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ErrClosing is returned from new calls of clients while driver is closing
+var ErrClosing = xerrors.Wrap(errors.New("ydb: driver is closing"))
+
+// Drainer is the interface of client with drain phase of close
+type Drainer interface {
+	// Drain rejects new calls and waits for in-flight calls of client
+	Drain(ctx context.Context) error
+	// Inflight returns count of in-flight calls of client
+	Inflight() int
+}
+
+// Gate counts in-flight calls and rejects new calls after start of drain.
+// Zero value of Gate is ready to use
+type Gate struct {
+	mu       sync.Mutex
+	closing  bool
+	inflight int
+	drained  chan struct{}
+}
+
+// Enter registers new call. Leave func must be called on end of call
+func (g *Gate) Enter() (leave func(), _ error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.closing {
+		return nil, xerrors.WithStackTrace(ErrClosing)
+	}
+
+	g.inflight++
+
+	var once sync.Once
+
+	return func() {
+		once.Do(g.leave)
+	}, nil
+}
+
+func (g *Gate) leave() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.inflight--
+	if g.inflight == 0 && g.drained != nil {
+		close(g.drained)
+		g.drained = nil
+	}
+}
+
+// Inflight returns count of in-flight calls
+func (g *Gate) Inflight() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.inflight
+}
+
+// Close rejects new calls without waiting for in-flight calls
+func (g *Gate) Close() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.closing = true
+}
+
+// Drain rejects new calls and waits for in-flight calls or done of context
+func (g *Gate) Drain(ctx context.Context) error {
+	g.mu.Lock()
+	g.closing = true
+	if g.inflight == 0 {
+		g.mu.Unlock()
+
+		return nil
+	}
+	if g.drained == nil {
+		g.drained = make(chan struct{})
+	}
+	drained := g.drained
+	g.mu.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return xerrors.WithStackTrace(ctx.Err())
+	}
+}
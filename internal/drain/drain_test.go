@@ -0,0 +1,59 @@
+package drain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGate(t *testing.T) {
+	var g Gate
+
+	leave1, err := g.Enter()
+	require.NoError(t, err)
+	leave2, err := g.Enter()
+	require.NoError(t, err)
+	require.Equal(t, 2, g.Inflight())
+
+	// double leave is a no-op
+	leave2()
+	leave2()
+	require.Equal(t, 1, g.Inflight())
+
+	drained := make(chan error, 1)
+	go func() {
+		drained <- g.Drain(context.Background())
+	}()
+
+	require.Eventually(t, func() bool {
+		_, err := g.Enter()
+
+		return errors.Is(err, ErrClosing)
+	}, time.Second, time.Millisecond)
+
+	select {
+	case <-drained:
+		t.Fatal("drain finished with in-flight call")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	leave1()
+	require.NoError(t, <-drained)
+	require.Zero(t, g.Inflight())
+}
+
+func TestGateDrainTimeout(t *testing.T) {
+	var g Gate
+
+	_, err := g.Enter()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, g.Drain(ctx), context.DeadlineExceeded)
+	require.Equal(t, 1, g.Inflight())
+}
@@ -3,14 +3,18 @@ package dsn
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
 var (
-	insecureSchema = "grpc"
-	databaseParam  = "database"
+	insecureSchema          = "grpc"
+	databaseParam           = "database"
+	discoveryParam          = "go_discovery"
+	discoveryEndpointsParam = "go_discovery_endpoints"
 )
 
 type UserInfo struct {
@@ -51,6 +55,41 @@ func Parse(dsn string) (info parsedInfo, err error) {
 		)
 		delete(info.Params, databaseParam)
 	}
+	if discovery := info.Params.Get(discoveryParam); discovery != "" {
+		option, err := discoveryOption(discovery, info.Params.Get(discoveryEndpointsParam))
+		if err != nil {
+			return info, xerrors.WithStackTrace(fmt.Errorf("bad connection string '%s': %w", dsn, err))
+		}
+		if option != nil {
+			info.Options = append(info.Options, option)
+		}
+		delete(info.Params, discoveryParam)
+		delete(info.Params, discoveryEndpointsParam)
+	}
 
 	return info, nil
 }
+
+func discoveryOption(discovery, endpoints string) (config.Option, error) {
+	t, ok := balancerConfig.DiscoveryTypeFromString(discovery)
+	if !ok {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("unknown discovery: %s", discovery))
+	}
+
+	var addresses []string
+	if endpoints != "" {
+		addresses = strings.Split(endpoints, ",")
+	}
+
+	switch t {
+	case balancerConfig.DiscoveryStatic:
+		return config.WithStaticDiscovery(addresses...), nil
+	case balancerConfig.DiscoveryDNS:
+		return config.WithDNSDiscovery(addresses...), nil
+	case balancerConfig.DiscoveryDNSSRV:
+		return config.WithDNSSRVDiscovery(addresses...), nil
+	default:
+		// discovery service is a default discovery
+		return nil, nil //nolint:nilnil
+	}
+}
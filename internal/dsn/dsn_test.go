@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
 )
 
 func TestParseConnectionString(t *testing.T) {
@@ -124,3 +125,58 @@ func TestParseConnectionString(t *testing.T) {
 		})
 	}
 }
+
+func TestParseConnectionStringDiscovery(t *testing.T) {
+	for _, test := range []struct {
+		connectionString string
+		discovery        balancerConfig.Discovery
+		err              bool
+	}{
+		{
+			connectionString: "grpc://localhost:2135/local",
+			discovery:        balancerConfig.Discovery{Type: balancerConfig.DiscoveryService},
+		},
+		{
+			connectionString: "grpc://localhost:2135/local?go_discovery=service",
+			discovery:        balancerConfig.Discovery{Type: balancerConfig.DiscoveryService},
+		},
+		{
+			connectionString: "grpc://localhost:2135/local?go_discovery=static",
+			discovery:        balancerConfig.Discovery{Type: balancerConfig.DiscoveryStatic},
+		},
+		{
+			connectionString: "grpc://localhost:2135/local?go_discovery=static&go_discovery_endpoints=a:2135,b:2135",
+			discovery: balancerConfig.Discovery{
+				Type:      balancerConfig.DiscoveryStatic,
+				Endpoints: []string{"a:2135", "b:2135"},
+			},
+		},
+		{
+			connectionString: "grpc://ydb.svc:2135/local?go_discovery=dns",
+			discovery:        balancerConfig.Discovery{Type: balancerConfig.DiscoveryDNS},
+		},
+		{
+			connectionString: "grpc://ydb.svc:2135/local?go_discovery=srv&go_discovery_endpoints=_grpc._tcp.ydb.svc",
+			discovery: balancerConfig.Discovery{
+				Type:      balancerConfig.DiscoveryDNSSRV,
+				Endpoints: []string{"_grpc._tcp.ydb.svc"},
+			},
+		},
+		{
+			connectionString: "grpc://localhost:2135/local?go_discovery=unknown",
+			err:              true,
+		},
+	} {
+		t.Run(test.connectionString, func(t *testing.T) {
+			info, err := Parse(test.connectionString)
+			if test.err {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Empty(t, info.Params)
+			require.Equal(t, test.discovery, config.New(info.Options...).Discovery())
+		})
+	}
+}
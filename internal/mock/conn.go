@@ -78,10 +78,11 @@ func (c *Conn) Unban(ctx context.Context) conn.State {
 }
 
 type Endpoint struct {
-	AddrField     string
-	LocationField string
-	NodeIDField   uint32
-	LocalDCField  bool
+	AddrField       string
+	LocationField   string
+	NodeIDField     uint32
+	LocalDCField    bool
+	LoadFactorField float32
 }
 
 func (e *Endpoint) Choose(bool) {
@@ -108,7 +109,7 @@ func (e *Endpoint) LastUpdated() time.Time {
 }
 
 func (e *Endpoint) LoadFactor() float32 {
-	panic("not implemented in mock")
+	return e.LoadFactorField
 }
 
 func (e *Endpoint) String() string {
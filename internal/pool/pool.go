@@ -9,6 +9,7 @@ import (
 
 	"github.com/jonboulle/clockwork"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
@@ -97,7 +98,7 @@ func (p *Pool[T]) try(ctx context.Context, f func(ctx context.Context, item *T)
 	}()
 
 	if err = f(ctx, item); err != nil {
-		if p.checkErr(err) {
+		if xcontext.MustDeleteSession(ctx, err, p.checkErr) {
 			_ = p.deleteItem(ctx, item)
 		}
 
@@ -10,9 +10,12 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 	"google.golang.org/grpc"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/drain"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -33,6 +36,20 @@ type Client struct {
 	config     *config.Config
 	grpcClient Ydb_Query_V1.QueryServiceClient
 	pool       *pool.Pool[Session]
+
+	// drain counts in-flight calls of Do and DoTx for graceful close
+	drain *drain.Gate
+}
+
+// Drain rejects new calls of Do and DoTx and waits for in-flight calls.
+// Sessions are not closed, so in-flight calls can be retried
+func (c Client) Drain(ctx context.Context) error {
+	return c.drain.Drain(ctx)
+}
+
+// Inflight returns count of in-flight calls of Do and DoTx
+func (c Client) Inflight() int {
+	return c.drain.Inflight()
 }
 
 func (c Client) Close(ctx context.Context) error {
@@ -53,14 +70,34 @@ func do(
 ) (finalErr error) {
 	doOpts := options.ParseDoOpts(t, opts...)
 
+	err := doWithRetries(ctx, pool, op, doOpts.Label(), doOpts.RetryOpts(),
+		func(ctx *context.Context) func(error) func(int, error) {
+			return trace.QueryOnDo(doOpts.Trace(), ctx, stack.FunctionID(""), doOpts.Label())
+		},
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func doWithRetries(
+	ctx context.Context,
+	pool *pool.Pool[Session],
+	op query.Operation,
+	label string,
+	retryOpts []retry.Option,
+	onRetry func(ctx *context.Context) func(error) func(attempts int, _ error),
+) error {
 	err := pool.With(ctx, func(ctx context.Context, s *Session) error {
-		err := op(ctx, s)
+		err := op(withLabel(ctx, label), s)
 		if err != nil {
 			return xerrors.WithStackTrace(err)
 		}
 
 		return nil
-	}, append(doOpts.RetryOpts(), retry.WithTrace(&trace.Retry{
+	}, append(retryOpts, retry.WithTrace(&trace.Retry{
 		OnRetry: func(
 			info trace.RetryLoopStartInfo,
 		) func(
@@ -68,7 +105,7 @@ func do(
 		) func(
 			trace.RetryLoopDoneInfo,
 		) {
-			onIntermediate := trace.QueryOnDo(doOpts.Trace(), &ctx, stack.FunctionID(""))
+			onIntermediate := onRetry(&ctx)
 
 			return func(info trace.RetryLoopIntermediateInfo) func(trace.RetryLoopDoneInfo) {
 				onDone := onIntermediate(info.Error)
@@ -87,7 +124,18 @@ func do(
 }
 
 func (c Client) Do(ctx context.Context, op query.Operation, opts ...options.DoOption) error {
-	return do(ctx, c.pool, op, c.config.Trace(), opts...)
+	leave, err := c.drain.Enter()
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer leave()
+
+	return do(ctx, c.pool, op, c.config.Trace(),
+		append([]options.DoOption{options.WithRetryOptions(
+			retry.WithBudget(c.config.RetryBudget()),
+			retry.WithPolicy(c.config.RetryPolicy()),
+		)}, opts...)...,
+	)
 }
 
 func doTx(
@@ -98,8 +146,14 @@ func doTx(
 	opts ...options.DoTxOption,
 ) error {
 	doTxOpts := options.ParseDoTxOpts(t, opts...)
+	// trace t already contained in do options of doTxOpts
+	doOpts := options.ParseDoOpts(&trace.Query{}, doTxOpts.DoOpts()...)
 
-	err := do(ctx, pool, func(ctx context.Context, s query.Session) error {
+	if isReadOnlyTx(doTxOpts.TxSettings()) {
+		ctx = xcontext.WithReadOnly(ctx)
+	}
+
+	err := doWithRetries(ctx, pool, func(ctx context.Context, s query.Session) error {
 		tx, err := s.Begin(ctx, doTxOpts.TxSettings())
 		if err != nil {
 			return xerrors.WithStackTrace(err)
@@ -124,7 +178,21 @@ func doTx(
 		}
 
 		return nil
-	}, t, doTxOpts.DoOpts()...)
+	}, doOpts.Label(), doOpts.RetryOpts(), func(ctx *context.Context) func(error) func(int, error) {
+		// DoTx is Do with transaction, both events emitted as before DoTx event
+		onDoIntermediate := trace.QueryOnDo(doOpts.Trace(), ctx, stack.FunctionID(""), doOpts.Label())
+		onDoTxIntermediate := trace.QueryOnDoTx(doOpts.Trace(), ctx, stack.FunctionID(""), doOpts.Label())
+
+		return func(err error) func(int, error) {
+			onDoDone := onDoIntermediate(err)
+			onDoTxDone := onDoTxIntermediate(err)
+
+			return func(attempts int, err error) {
+				onDoTxDone(attempts, err)
+				onDoDone(attempts, err)
+			}
+		}
+	})
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -132,8 +200,34 @@ func doTx(
 	return nil
 }
 
+// isReadOnlyTx checks transaction settings for read-only transaction mode
+func isReadOnlyTx(settings tx.Settings) bool {
+	a := allocator.New()
+	defer a.Free()
+
+	switch settings.ToYDB(a).GetTxMode().(type) {
+	case *Ydb_Query.TransactionSettings_OnlineReadOnly,
+		*Ydb_Query.TransactionSettings_StaleReadOnly,
+		*Ydb_Query.TransactionSettings_SnapshotReadOnly:
+		return true
+	default:
+		return false
+	}
+}
+
 func (c Client) DoTx(ctx context.Context, op query.TxOperation, opts ...options.DoTxOption) error {
-	return doTx(ctx, c.pool, op, c.config.Trace(), opts...)
+	leave, err := c.drain.Enter()
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer leave()
+
+	return doTx(ctx, c.pool, op, c.config.Trace(),
+		append([]options.DoTxOption{options.WithRetryOptions(
+			retry.WithBudget(c.config.RetryBudget()),
+			retry.WithPolicy(c.config.RetryPolicy()),
+		)}, opts...)...,
+	)
 }
 
 func deleteSession(ctx context.Context, client Ydb_Query_V1.QueryServiceClient, sessionID string) error {
@@ -215,6 +309,7 @@ func createSession(
 		nodeID:      s.GetNodeId(),
 		queryClient: client,
 		status:      query.SessionStatusReady,
+		trace:       &trace.Query{},
 	}
 
 	if cfg.onAttach != nil {
@@ -258,6 +353,7 @@ func New(ctx context.Context, balancer balancer, config *config.Config) (*Client
 	client := &Client{
 		config:     config,
 		grpcClient: Ydb_Query_V1.NewQueryServiceClient(balancer),
+		drain:      &drain.Gate{},
 	}
 
 	client.pool = pool.New(
@@ -271,12 +367,20 @@ func New(ctx context.Context, balancer balancer, config *config.Config) (*Client
 			}
 			defer cancel()
 
+			onDone := trace.QueryOnSessionCreate(config.Trace(), &ctx, stack.FunctionID(""))
 			s, err := createSession(ctx, client.grpcClient, createSessionConfig{
-				onClose: onClose,
+				onClose: func(s *Session) {
+					trace.QueryOnSessionClose(config.Trace(), s.id, s.nodeID)
+					onClose(s)
+				},
 			})
 			if err != nil {
+				onDone("", 0, err)
+
 				return nil, xerrors.WithStackTrace(err)
 			}
+			s.trace = config.Trace()
+			onDone(s.id, s.nodeID, nil)
 
 			return s, nil
 		},
@@ -289,7 +393,9 @@ func New(ctx context.Context, balancer balancer, config *config.Config) (*Client
 			}
 			defer cancel()
 
+			onDone := trace.QueryOnSessionDelete(config.Trace(), &ctx, stack.FunctionID(""), s.id, s.nodeID)
 			err := deleteSession(ctx, client.grpcClient, s.id)
+			onDone(err)
 			if err != nil {
 				return xerrors.WithStackTrace(err)
 			}
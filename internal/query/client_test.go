@@ -14,7 +14,10 @@ import (
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/drain"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
@@ -151,6 +154,7 @@ func TestCreateSession(t *testing.T) {
 
 func newTestSession() (*Session, error) {
 	return &Session{
+		trace: &trace.Query{},
 		close: func() {},
 	}, nil
 }
@@ -158,6 +162,7 @@ func newTestSession() (*Session, error) {
 func newTestSessionWithClient(client Ydb_Query_V1.QueryServiceClient) (*Session, error) {
 	return &Session{
 		queryClient: client,
+		trace:       &trace.Query{},
 		close:       func() {},
 	}, nil
 }
@@ -203,6 +208,57 @@ func TestDo(t *testing.T) {
 	})
 }
 
+func TestClientDrain(t *testing.T) {
+	ctx := xtest.Context(t)
+	client := &Client{
+		config: config.New(),
+		pool: newTestPool(func(ctx context.Context) (*Session, error) {
+			return newTestSession()
+		}),
+		drain: &drain.Gate{},
+	}
+
+	started, finish := make(chan struct{}), make(chan struct{})
+	doErr := make(chan error, 1)
+	go func() {
+		doErr <- client.Do(ctx, func(ctx context.Context, s query.Session) error {
+			close(started)
+			<-finish
+
+			return nil
+		})
+	}()
+	<-started
+	require.Equal(t, 1, client.Inflight())
+
+	drained := make(chan error, 1)
+	go func() {
+		drained <- client.Drain(ctx)
+	}()
+	require.Eventually(t, func() bool {
+		// new calls are rejected after start of drain
+		err := client.Do(ctx, func(ctx context.Context, s query.Session) error {
+			return nil
+		})
+
+		return errors.Is(err, drain.ErrClosing)
+	}, time.Second, time.Millisecond)
+	require.ErrorIs(t, client.DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		return nil
+	}), drain.ErrClosing)
+
+	// in-flight call is finished on drain
+	select {
+	case err := <-drained:
+		t.Fatalf("drain finished before in-flight call: %v", err)
+	default:
+	}
+	close(finish)
+	require.NoError(t, <-doErr)
+	require.NoError(t, <-drained)
+	require.Equal(t, 0, client.Inflight())
+}
+
 func TestDoTx(t *testing.T) {
 	ctx := xtest.Context(t)
 	t.Run("HappyWay", func(t *testing.T) {
@@ -247,4 +303,54 @@ func TestDoTx(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 10, counter)
 	})
+	t.Run("Trace", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().BeginTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.BeginTransactionResponse{
+			Status: Ydb.StatusIds_SUCCESS,
+		}, nil)
+		client.EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.CommitTransactionResponse{
+			Status: Ydb.StatusIds_SUCCESS,
+		}, nil)
+		var (
+			doCalls   int
+			doTxCalls int
+			attempts  int
+			label     string
+		)
+		err := doTx(ctx, newTestPool(func(ctx context.Context) (*Session, error) {
+			return newTestSessionWithClient(client)
+		}), func(ctx context.Context, tx query.TxActor) error {
+			require.Equal(t, "test", labelFromContext(ctx))
+
+			return nil
+		}, &trace.Query{
+			OnDo: func(trace.QueryDoStartInfo) func(trace.QueryDoIntermediateInfo) func(trace.QueryDoDoneInfo) {
+				doCalls++
+
+				return nil
+			},
+			OnDoTx: func(
+				info trace.QueryDoTxStartInfo,
+			) func(
+				trace.QueryDoTxIntermediateInfo,
+			) func(
+				trace.QueryDoTxDoneInfo,
+			) {
+				doTxCalls++
+				label = info.Label
+
+				return func(trace.QueryDoTxIntermediateInfo) func(trace.QueryDoTxDoneInfo) {
+					return func(info trace.QueryDoTxDoneInfo) {
+						attempts = info.Attempts
+					}
+				}
+			},
+		}, options.WithLabel("test"))
+		require.NoError(t, err)
+		require.Equal(t, 1, doCalls)
+		require.Equal(t, 1, doTxCalls)
+		require.Equal(t, "test", label)
+		require.Equal(t, 1, attempts)
+	})
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/jonboulle/clockwork"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -23,6 +24,8 @@ type Config struct {
 	createSessionTimeout time.Duration
 	deleteTimeout        time.Duration
 
+	retryPolicy retry.Policy
+
 	trace *trace.Query
 
 	clock clockwork.Clock
@@ -77,3 +80,9 @@ func (c *Config) CreateSessionTimeout() time.Duration {
 func (c *Config) DeleteTimeout() time.Duration {
 	return c.deleteTimeout
 }
+
+// RetryPolicy is a retry policy of query client calls.
+// If RetryPolicy is nil then the default retry policy is used.
+func (c *Config) RetryPolicy() retry.Policy {
+	return c.retryPolicy
+}
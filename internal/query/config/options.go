@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -55,3 +56,10 @@ func WithDeleteTimeout(deleteTimeout time.Duration) Option {
 		}
 	}
 }
+
+// WithRetryPolicy replaces default retry policy of query client calls.
+func WithRetryPolicy(p retry.Policy) Option {
+	return func(c *Config) {
+		c.retryPolicy = p
+	}
+}
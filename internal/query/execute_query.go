@@ -10,11 +10,30 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
+type labelCtxKey struct{}
+
+// withLabel put label of Do or DoTx call to context for trace events of executes inside the call
+func withLabel(ctx context.Context, label string) context.Context {
+	if label == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, labelCtxKey{}, label)
+}
+
+func labelFromContext(ctx context.Context) string {
+	label, _ := ctx.Value(labelCtxKey{}).(string)
+
+	return label
+}
+
 type executeConfig interface {
 	ExecMode() options.ExecMode
 	StatsMode() options.StatsMode
@@ -55,6 +74,12 @@ func queryFromText(
 func execute(ctx context.Context, s *Session, c Ydb_Query_V1.QueryServiceClient, q string, cfg executeConfig) (
 	_ *transaction, _ *result, finalErr error,
 ) {
+	label := labelFromContext(ctx)
+	onDone := trace.QueryOnSessionExecute(s.trace, &ctx, stack.FunctionID(""), s.id, q, label)
+	defer func() {
+		onDone(finalErr)
+	}()
+
 	a := allocator.New()
 	defer a.Free()
 
@@ -72,7 +97,9 @@ func execute(ctx context.Context, s *Session, c Ydb_Query_V1.QueryServiceClient,
 		return nil, nil, xerrors.WithStackTrace(err)
 	}
 
-	r, txID, err := newResult(ctx, stream, streamCancel)
+	r, txID, err := newResult(ctx, stream, streamCancel, func(bytesCount int) {
+		trace.QueryOnResultNextPart(s.trace, s.id, label, bytesCount)
+	})
 	if err != nil {
 		return nil, nil, xerrors.WithStackTrace(err)
 	}
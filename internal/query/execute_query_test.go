@@ -19,6 +19,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func TestExecute(t *testing.T) {
@@ -356,7 +357,7 @@ func TestExecute(t *testing.T) {
 		service := NewMockQueryServiceClient(ctrl)
 		service.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
 		t.Log("execute")
-		tx, r, err := execute(ctx, &Session{id: "123"}, service, "", options.ExecuteSettings())
+		tx, r, err := execute(ctx, &Session{id: "123", trace: &trace.Query{}}, service, "", options.ExecuteSettings())
 		require.NoError(t, err)
 		defer r.Close(ctx)
 		require.EqualValues(t, "456", tx.id)
@@ -469,7 +470,7 @@ func TestExecute(t *testing.T) {
 			service := NewMockQueryServiceClient(ctrl)
 			service.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(nil, grpcStatus.Error(grpcCodes.Unavailable, ""))
 			t.Log("execute")
-			_, _, err := execute(ctx, &Session{id: "123"}, service, "", options.ExecuteSettings())
+			_, _, err := execute(ctx, &Session{id: "123", trace: &trace.Query{}}, service, "", options.ExecuteSettings())
 			require.Error(t, err)
 			require.True(t, xerrors.IsTransportError(err, grpcCodes.Unavailable))
 		})
@@ -573,7 +574,7 @@ func TestExecute(t *testing.T) {
 			service := NewMockQueryServiceClient(ctrl)
 			service.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
 			t.Log("execute")
-			tx, r, err := execute(ctx, &Session{id: "123"}, service, "", options.ExecuteSettings())
+			tx, r, err := execute(ctx, &Session{id: "123", trace: &trace.Query{}}, service, "", options.ExecuteSettings())
 			require.NoError(t, err)
 			defer r.Close(ctx)
 			require.EqualValues(t, "456", tx.id)
@@ -637,7 +638,7 @@ func TestExecute(t *testing.T) {
 			service := NewMockQueryServiceClient(ctrl)
 			service.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
 			t.Log("execute")
-			_, _, err := execute(ctx, &Session{id: "123"}, service, "", options.ExecuteSettings())
+			_, _, err := execute(ctx, &Session{id: "123", trace: &trace.Query{}}, service, "", options.ExecuteSettings())
 			require.Error(t, err)
 			require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_UNAVAILABLE))
 		})
@@ -713,7 +714,7 @@ func TestExecute(t *testing.T) {
 			service := NewMockQueryServiceClient(ctrl)
 			service.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
 			t.Log("execute")
-			tx, r, err := execute(ctx, &Session{id: "123"}, service, "", options.ExecuteSettings())
+			tx, r, err := execute(ctx, &Session{id: "123", trace: &trace.Query{}}, service, "", options.ExecuteSettings())
 			require.NoError(t, err)
 			defer r.Close(ctx)
 			require.EqualValues(t, "456", tx.id)
@@ -36,6 +36,11 @@ type (
 	TxExecuteOption interface {
 		applyTxExecuteOption(s *txExecuteSettings)
 	}
+	// ParametersOption is option of query parameters for execute in session and in transaction
+	ParametersOption interface {
+		ExecuteOption
+		TxExecuteOption
+	}
 	txCommitOption   struct{}
 	parametersOption params.Parameters
 	txControlOption  struct {
@@ -183,12 +188,13 @@ func WithParameters(parameters *params.Parameters) *parametersOption {
 }
 
 var (
-	_ ExecuteOption   = ExecMode(0)
-	_ ExecuteOption   = StatsMode(0)
-	_ TxExecuteOption = ExecMode(0)
-	_ TxExecuteOption = StatsMode(0)
-	_ TxExecuteOption = txCommitOption{}
-	_ ExecuteOption   = txControlOption{}
+	_ ExecuteOption    = ExecMode(0)
+	_ ExecuteOption    = StatsMode(0)
+	_ TxExecuteOption  = ExecMode(0)
+	_ TxExecuteOption  = StatsMode(0)
+	_ TxExecuteOption  = txCommitOption{}
+	_ ParametersOption = (*parametersOption)(nil)
+	_ ExecuteOption    = txControlOption{}
 )
 
 func WithCommit() txCommitOption {
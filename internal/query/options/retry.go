@@ -10,11 +10,13 @@ var (
 	_ DoOption = idempotentOption{}
 	_ DoOption = labelOption("")
 	_ DoOption = traceOption{}
+	_ DoOption = retryOptionsOption{}
 
 	_ DoTxOption = idempotentOption{}
 	_ DoTxOption = labelOption("")
 	_ DoTxOption = traceOption{}
 	_ DoTxOption = doTxSettingsOption{}
+	_ DoTxOption = retryOptionsOption{}
 )
 
 type (
@@ -25,6 +27,7 @@ type (
 	doSettings struct {
 		retryOpts []retry.Option
 		trace     *trace.Query
+		label     string
 	}
 
 	DoTxOption interface {
@@ -44,12 +47,17 @@ type (
 	doTxSettingsOption struct {
 		txSettings tx.Settings
 	}
+	retryOptionsOption []retry.Option
 )
 
 func (s *doSettings) Trace() *trace.Query {
 	return s.trace
 }
 
+func (s *doSettings) Label() string {
+	return s.label
+}
+
 func (s *doSettings) RetryOpts() []retry.Option {
 	return s.retryOpts
 }
@@ -79,6 +87,7 @@ func (opt traceOption) applyDoTxOption(s *doTxSettings) {
 }
 
 func (opt labelOption) applyDoOption(s *doSettings) {
+	s.label = string(opt)
 	s.retryOpts = append(s.retryOpts, retry.WithLabel(string(opt)))
 }
 
@@ -86,6 +95,14 @@ func (opt labelOption) applyDoTxOption(s *doTxSettings) {
 	s.doOpts = append(s.doOpts, opt)
 }
 
+func (opts retryOptionsOption) applyDoOption(s *doSettings) {
+	s.retryOpts = append(s.retryOpts, opts...)
+}
+
+func (opts retryOptionsOption) applyDoTxOption(s *doTxSettings) {
+	s.doOpts = append(s.doOpts, opts)
+}
+
 func (opt doTxSettingsOption) applyDoTxOption(opts *doTxSettings) {
 	opts.txSettings = opt.txSettings
 }
@@ -94,6 +111,10 @@ func WithTxSettings(txSettings tx.Settings) doTxSettingsOption {
 	return doTxSettingsOption{txSettings: txSettings}
 }
 
+func WithRetryOptions(opts ...retry.Option) retryOptionsOption {
+	return opts
+}
+
 func WithIdempotent() idempotentOption {
 	return idempotentOption{}
 }
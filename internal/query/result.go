@@ -8,6 +8,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
@@ -17,6 +18,7 @@ var _ query.Result = (*result)(nil)
 
 type result struct {
 	stream         Ydb_Query_V1.QueryService_ExecuteQueryClient
+	onNextPart     func(bytesCount int)
 	closeOnce      func()
 	lastPart       *Ydb_Query.ExecuteQueryResponsePart
 	resultSetIndex int64
@@ -28,6 +30,7 @@ func newResult(
 	ctx context.Context,
 	stream Ydb_Query_V1.QueryService_ExecuteQueryClient,
 	streamCancel func(),
+	onNextPart func(bytesCount int),
 ) (_ *result, txID string, err error) {
 	select {
 	case <-ctx.Done():
@@ -39,6 +42,9 @@ func newResult(
 
 			return nil, txID, xerrors.WithStackTrace(err)
 		}
+		if onNextPart != nil {
+			onNextPart(proto.Size(part))
+		}
 		var (
 			interrupted = make(chan struct{})
 			closed      = make(chan struct{})
@@ -51,6 +57,7 @@ func newResult(
 
 		return &result{
 			stream:         stream,
+			onNextPart:     onNextPart,
 			resultSetIndex: -1,
 			lastPart:       part,
 			closed:         closed,
@@ -70,6 +77,18 @@ func nextPart(
 	return part, nil
 }
 
+func (r *result) nextPart() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+	part, err := nextPart(r.stream)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if r.onNextPart != nil {
+		r.onNextPart(proto.Size(part))
+	}
+
+	return part, nil
+}
+
 func (r *result) Close(ctx context.Context) error {
 	r.closeOnce()
 
@@ -107,7 +126,7 @@ func (r *result) nextResultSet(ctx context.Context) (_ *resultSet, err error) {
 					case <-r.closed:
 						return nil, errClosedResult
 					default:
-						part, err := nextPart(r.stream)
+						part, err := r.nextPart()
 						if err != nil {
 							if xerrors.Is(err, io.EOF) {
 								r.closeOnce()
@@ -127,7 +146,7 @@ func (r *result) nextResultSet(ctx context.Context) (_ *resultSet, err error) {
 					}
 				}, r.lastPart), nil
 			}
-			part, err := nextPart(r.stream)
+			part, err := r.nextPart()
 			if err != nil {
 				return nil, xerrors.WithStackTrace(err)
 			}
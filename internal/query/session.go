@@ -12,6 +12,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 var _ query.Session = (*Session)(nil)
@@ -21,6 +22,7 @@ type Session struct {
 	nodeID      int64
 	queryClient Ydb_Query_V1.QueryServiceClient
 	status      query.SessionStatus
+	trace       *trace.Query
 	close       func()
 }
 
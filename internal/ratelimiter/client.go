@@ -14,9 +14,11 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/config"
 	ratelimiterErrors "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/errors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/ratelimiter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 var (
@@ -323,6 +325,13 @@ func (c *Client) AcquireResource(
 	if c == nil {
 		return xerrors.WithStackTrace(errNilClient)
 	}
+	onDone := trace.RatelimiterOnAcquireResource(c.config.Trace(), &ctx, stack.FunctionID(""),
+		coordinationNodePath, resourcePath, amount,
+		options.NewAcquire(opts...).Type() == options.AcquireTypeReport,
+	)
+	defer func() {
+		onDone(err)
+	}()
 	call := func(ctx context.Context) error {
 		return xerrors.WithStackTrace(c.acquireResource(ctx, coordinationNodePath, resourcePath, amount, opts...))
 	}
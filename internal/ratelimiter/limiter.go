@@ -0,0 +1,273 @@
+package ratelimiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/ratelimiter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+var (
+	errLimiterClosed = xerrors.Wrap(errors.New("ratelimiter limiter closed"))
+	errExceedsBurst  = xerrors.Wrap(errors.New("ratelimiter limiter wait amount exceeds burst"))
+)
+
+var _ ratelimiter.Limiter = (*limiter)(nil)
+
+// limiter is a local token bucket over quota, which prefetched from server.
+// Bucket refilled with rate up to burst, spend of units requires tokens of bucket and prefetched quota
+type limiter struct {
+	client               *Client
+	coordinationNodePath string
+	resourcePath         string
+	prefetch             uint64
+	watermark            uint64
+	// rate is refill rate of bucket in units per second, zero rate means bucket without local limit
+	rate  float64
+	burst uint64
+
+	// ctx of background prefetches, cancelled on Close
+	ctx    context.Context //nolint:containedctx
+	cancel context.CancelFunc
+
+	mutex sync.Mutex
+	// quota is prefetched from server and not spent units
+	quota uint64
+	// tokens of local bucket and time of last refill of bucket
+	tokens     float64
+	lastRefill time.Time
+	// fetching is not nil during prefetch, it closed after prefetch done
+	fetching chan struct{}
+	// fetchErr is error of last prefetch
+	fetchErr error
+	closed   bool
+}
+
+func (c *Client) Limiter(
+	coordinationNodePath string,
+	resourcePath string,
+	opts ...options.LimiterOption,
+) (_ ratelimiter.Limiter, err error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+
+	limiterOptions := options.NewLimiter(opts...)
+	ctx, cancel := xcontext.WithCancel(context.Background())
+
+	burst := limiterOptions.Burst()
+	if burst == 0 {
+		burst = limiterOptions.Prefetch()
+	}
+
+	return &limiter{
+		client:               c,
+		coordinationNodePath: coordinationNodePath,
+		resourcePath:         resourcePath,
+		prefetch:             limiterOptions.Prefetch(),
+		watermark:            uint64(float64(limiterOptions.Prefetch()) * limiterOptions.PrefetchWatermark()),
+		rate:                 limiterOptions.Rate(),
+		burst:                burst,
+		ctx:                  ctx,
+		cancel:               cancel,
+		tokens:               float64(burst),
+		lastRefill:           time.Now(),
+	}, nil
+}
+
+func (l *limiter) Wait(ctx context.Context, n uint64) error {
+	local := true
+	for {
+		l.mutex.Lock()
+		if l.closed {
+			l.mutex.Unlock()
+
+			return xerrors.WithStackTrace(errLimiterClosed)
+		}
+		if l.rate > 0 && n > l.burst {
+			l.mutex.Unlock()
+
+			return xerrors.WithStackTrace(fmt.Errorf("%w: %d > %d", errExceedsBurst, n, l.burst))
+		}
+		delay, ok := l.spendLocked(n, time.Now())
+		if ok {
+			l.mutex.Unlock()
+			trace.RatelimiterOnLimiterSpend(l.client.config.Trace(), l.resourcePath, n, local)
+
+			return nil
+		}
+		if l.quota >= n {
+			// wait refill of local bucket only
+			l.mutex.Unlock()
+
+			if err := wait(ctx, delay); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+
+			continue
+		}
+		if !local && l.fetchErr != nil && l.fetching == nil {
+			err := l.fetchErr
+			l.fetchErr = nil
+			l.mutex.Unlock()
+
+			return xerrors.WithStackTrace(err)
+		}
+		fetching := l.fetchLocked(n - l.quota)
+		l.mutex.Unlock()
+
+		local = false
+
+		select {
+		case <-ctx.Done():
+			return xerrors.WithStackTrace(ctx.Err())
+		case <-fetching:
+		}
+	}
+}
+
+func (l *limiter) Allow(n uint64) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.closed {
+		return false
+	}
+	if _, ok := l.spendLocked(n, time.Now()); !ok {
+		if l.quota < n {
+			l.fetchLocked(n - l.quota)
+		}
+
+		return false
+	}
+	trace.RatelimiterOnLimiterSpend(l.client.config.Trace(), l.resourcePath, n, true)
+
+	return true
+}
+
+// Close stops prefetch of quota and reports unspent prefetched quota to server with options.WithReport
+func (l *limiter) Close(ctx context.Context) error {
+	l.mutex.Lock()
+	if l.closed {
+		l.mutex.Unlock()
+
+		return xerrors.WithStackTrace(errLimiterClosed)
+	}
+	l.closed = true
+	l.cancel()
+	fetching := l.fetching
+	l.mutex.Unlock()
+
+	if fetching != nil {
+		select {
+		case <-ctx.Done():
+			return xerrors.WithStackTrace(ctx.Err())
+		case <-fetching:
+		}
+	}
+
+	l.mutex.Lock()
+	unspent := l.quota
+	l.quota = 0
+	l.mutex.Unlock()
+
+	if unspent == 0 {
+		return nil
+	}
+
+	err := l.client.AcquireResource(ctx, l.coordinationNodePath, l.resourcePath, unspent,
+		options.WithReport(),
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// refillLocked adds tokens to local bucket according to rate and time since last refill
+func (l *limiter) refillLocked(now time.Time) {
+	if l.rate <= 0 {
+		return
+	}
+	if elapsed := now.Sub(l.lastRefill); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > float64(l.burst) {
+			l.tokens = float64(l.burst)
+		}
+	}
+	l.lastRefill = now
+}
+
+// spendLocked spends n units if local bucket and prefetched quota have them and starts background
+// prefetch if quota less than watermark after spend. If local bucket has no n tokens spendLocked
+// returns delay until refill of bucket
+func (l *limiter) spendLocked(n uint64, now time.Time) (delay time.Duration, ok bool) {
+	l.refillLocked(now)
+	if l.rate > 0 && l.tokens < float64(n) {
+		return time.Duration((float64(n) - l.tokens) / l.rate * float64(time.Second)), false
+	}
+	if l.quota < n {
+		return 0, false
+	}
+	l.quota -= n
+	if l.rate > 0 {
+		l.tokens -= float64(n)
+	}
+	if l.quota < l.watermark {
+		l.fetchLocked(l.prefetch)
+	}
+
+	return 0, true
+}
+
+// fetchLocked starts prefetch of at least required units if prefetch is not started yet
+func (l *limiter) fetchLocked(required uint64) <-chan struct{} {
+	if l.fetching != nil {
+		return l.fetching
+	}
+
+	amount := l.prefetch
+	if required > amount {
+		amount = required
+	}
+	fetching := make(chan struct{})
+	l.fetching = fetching
+
+	go func() {
+		err := l.client.AcquireResource(l.ctx, l.coordinationNodePath, l.resourcePath, amount,
+			options.WithAcquire(),
+		)
+
+		l.mutex.Lock()
+		defer l.mutex.Unlock()
+
+		if err == nil {
+			l.quota += amount
+		}
+		l.fetchErr = err
+		l.fetching = nil
+		close(fetching)
+	}()
+
+	return fetching
+}
+
+func wait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,194 @@
+package ratelimiter
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_RateLimiter_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_RateLimiter"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type testRatelimiterService struct {
+	Ydb_RateLimiter_V1.RateLimiterServiceClient
+
+	mutex    sync.Mutex
+	required []uint64
+	used     []uint64
+	// release blocks acquire requests if not nil
+	release chan struct{}
+}
+
+func (s *testRatelimiterService) AcquireResource(
+	ctx context.Context,
+	in *Ydb_RateLimiter.AcquireResourceRequest,
+	_ ...grpc.CallOption,
+) (*Ydb_RateLimiter.AcquireResourceResponse, error) {
+	s.mutex.Lock()
+	if in.GetUsed() > 0 {
+		s.used = append(s.used, in.GetUsed())
+		s.mutex.Unlock()
+
+		return &Ydb_RateLimiter.AcquireResourceResponse{}, nil
+	}
+	s.required = append(s.required, in.GetRequired())
+	release := s.release
+	s.mutex.Unlock()
+
+	if release != nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-release:
+		}
+	}
+
+	return &Ydb_RateLimiter.AcquireResourceResponse{}, nil
+}
+
+func (s *testRatelimiterService) reports() []uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]uint64(nil), s.used...)
+}
+
+func (s *testRatelimiterService) requests() []uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]uint64(nil), s.required...)
+}
+
+func newTestLimiter(
+	t *testing.T,
+	service *testRatelimiterService,
+	spends map[bool]int,
+	opts ...options.LimiterOption,
+) *limiter {
+	var mutex sync.Mutex
+	c := &Client{
+		config: config.New(config.WithTrace(trace.Ratelimiter{
+			OnLimiterSpend: func(info trace.RatelimiterLimiterSpendInfo) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				spends[info.Local]++
+			},
+		})),
+		service: service,
+	}
+	l, err := c.Limiter("/local/node", "resource", opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = l.Close(context.Background())
+	})
+
+	return l.(*limiter) //nolint:forcetypeassert
+}
+
+func TestLimiterPrefetch(t *testing.T) {
+	ctx := xtest.Context(t)
+	service := &testRatelimiterService{}
+	spends := make(map[bool]int)
+	l := newTestLimiter(t, service, spends, options.WithPrefetch(10), options.WithPrefetchWatermark(0))
+
+	require.NoError(t, l.Wait(ctx, 1))
+	for i := 0; i < 9; i++ {
+		require.True(t, l.Allow(1))
+	}
+	require.Equal(t, []uint64{10}, service.requests())
+
+	// local quota exhausted, prefetch started in background
+	xtest.SpinWaitCondition(t, nil, func() bool {
+		return l.Allow(1)
+	})
+	require.Equal(t, []uint64{10, 10}, service.requests())
+
+	// amount greater than prefetch acquired by one request
+	require.NoError(t, l.Wait(ctx, 25))
+	require.Equal(t, []uint64{10, 10, 16}, service.requests())
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	require.Equal(t, 2, spends[false])
+	require.Equal(t, 10, spends[true])
+	require.Equal(t, 0, int(l.quota))
+}
+
+func TestLimiterWatermark(t *testing.T) {
+	ctx := xtest.Context(t)
+	service := &testRatelimiterService{}
+	spends := make(map[bool]int)
+	l := newTestLimiter(t, service, spends, options.WithPrefetch(10), options.WithPrefetchWatermark(0.5))
+
+	require.NoError(t, l.Wait(ctx, 6))
+	// prefetch started after local quota became less than watermark
+	xtest.SpinWaitCondition(t, nil, func() bool {
+		return len(service.requests()) == 2
+	})
+	require.NoError(t, l.Wait(ctx, 14))
+	require.Len(t, service.requests(), 2)
+}
+
+func TestLimiterWaitCancel(t *testing.T) {
+	ctx := xtest.Context(t)
+	service := &testRatelimiterService{release: make(chan struct{})}
+	l := newTestLimiter(t, service, make(map[bool]int), options.WithPrefetch(10))
+
+	require.False(t, l.Allow(1))
+
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, l.Wait(waitCtx, 1), context.DeadlineExceeded)
+
+	// quota prefetched by cancelled wait is not lost
+	close(service.release)
+	require.NoError(t, l.Wait(ctx, 10))
+	require.Equal(t, []uint64{10}, service.requests())
+
+	require.NoError(t, l.Close(ctx))
+	require.ErrorIs(t, l.Wait(ctx, 1), errLimiterClosed)
+	require.False(t, l.Allow(1))
+}
+
+func TestLimiterRate(t *testing.T) {
+	ctx := xtest.Context(t)
+	service := &testRatelimiterService{}
+	l := newTestLimiter(t, service, make(map[bool]int),
+		options.WithPrefetch(100),
+		options.WithRate(100, 2),
+	)
+
+	require.ErrorIs(t, l.Wait(ctx, 3), errExceedsBurst)
+
+	// burst available at start
+	require.NoError(t, l.Wait(ctx, 2))
+	require.False(t, l.Allow(1))
+
+	// bucket refilled with rate
+	start := time.Now()
+	require.NoError(t, l.Wait(ctx, 2))
+	require.GreaterOrEqual(t, time.Since(start), 15*time.Millisecond)
+	require.Equal(t, []uint64{100}, service.requests())
+}
+
+func TestLimiterCloseReportsUnspent(t *testing.T) {
+	ctx := xtest.Context(t)
+	service := &testRatelimiterService{}
+	l := newTestLimiter(t, service, make(map[bool]int),
+		options.WithPrefetch(10),
+		options.WithPrefetchWatermark(0),
+	)
+
+	require.NoError(t, l.Wait(ctx, 3))
+	require.NoError(t, l.Close(ctx))
+	require.Equal(t, []uint64{7}, service.reports())
+}
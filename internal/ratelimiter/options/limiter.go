@@ -0,0 +1,85 @@
+package options
+
+const (
+	DefaultPrefetch          = 100
+	DefaultPrefetchWatermark = 0.5
+)
+
+type Limiter interface {
+	// Prefetch defines count of units acquired from server by one request
+	Prefetch() uint64
+
+	// PrefetchWatermark defines part of prefetch, background prefetch starts if local quota less than
+	// PrefetchWatermark * Prefetch
+	PrefetchWatermark() float64
+
+	// Rate defines refill rate of local token bucket in units per second, zero means no local rate limit
+	Rate() float64
+
+	// Burst defines capacity of local token bucket, zero means prefetch
+	Burst() uint64
+}
+
+type limiterOptionsHolder struct {
+	prefetch          uint64
+	prefetchWatermark float64
+	rate              float64
+	burst             uint64
+}
+
+func (h *limiterOptionsHolder) Prefetch() uint64 {
+	return h.prefetch
+}
+
+func (h *limiterOptionsHolder) PrefetchWatermark() float64 {
+	return h.prefetchWatermark
+}
+
+func (h *limiterOptionsHolder) Rate() float64 {
+	return h.rate
+}
+
+func (h *limiterOptionsHolder) Burst() uint64 {
+	return h.burst
+}
+
+type LimiterOption func(h *limiterOptionsHolder)
+
+func WithPrefetch(prefetch uint64) LimiterOption {
+	return func(h *limiterOptionsHolder) {
+		if prefetch > 0 {
+			h.prefetch = prefetch
+		}
+	}
+}
+
+func WithPrefetchWatermark(prefetchWatermark float64) LimiterOption {
+	return func(h *limiterOptionsHolder) {
+		if prefetchWatermark >= 0 && prefetchWatermark <= 1 {
+			h.prefetchWatermark = prefetchWatermark
+		}
+	}
+}
+
+func WithRate(rate float64, burst uint64) LimiterOption {
+	return func(h *limiterOptionsHolder) {
+		if rate >= 0 {
+			h.rate = rate
+			h.burst = burst
+		}
+	}
+}
+
+func NewLimiter(opts ...LimiterOption) Limiter {
+	h := &limiterOptionsHolder{
+		prefetch:          DefaultPrefetch,
+		prefetchWatermark: DefaultPrefetchWatermark,
+	}
+	for _, o := range opts {
+		if o != nil {
+			o(h)
+		}
+	}
+
+	return h
+}
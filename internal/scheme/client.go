@@ -270,20 +270,23 @@ func (c *Client) ModifyPermissions(
 }
 
 func (c *Client) modifyPermissions(ctx context.Context, path string, desc permissionsDesc) (err error) {
-	_, err = c.service.ModifyPermissions(
-		ctx,
-		&Ydb_Scheme.ModifyPermissionsRequest{
-			Path:             path,
-			Actions:          desc.actions,
-			ClearPermissions: desc.clear,
-			OperationParams: operation.Params(
-				ctx,
-				c.config.OperationTimeout(),
-				c.config.OperationCancelAfter(),
-				operation.ModeSync,
-			),
-		},
-	)
+	request := &Ydb_Scheme.ModifyPermissionsRequest{
+		Path:             path,
+		Actions:          desc.actions,
+		ClearPermissions: desc.clear,
+		OperationParams: operation.Params(
+			ctx,
+			c.config.OperationTimeout(),
+			c.config.OperationCancelAfter(),
+			operation.ModeSync,
+		),
+	}
+	if desc.interruptInheritance != nil {
+		request.Inheritance = &Ydb_Scheme.ModifyPermissionsRequest_InterruptInheritance{
+			InterruptInheritance: *desc.interruptInheritance,
+		}
+	}
+	_, err = c.service.ModifyPermissions(ctx, request)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
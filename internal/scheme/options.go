@@ -5,12 +5,19 @@ import "github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
 type permissionsDesc struct {
 	clear   bool
 	actions []*Ydb_Scheme.PermissionsAction
+
+	// interruptInheritance is nil if inheritance not changed
+	interruptInheritance *bool
 }
 
 func (p *permissionsDesc) SetClear(clear bool) {
 	p.clear = clear
 }
 
+func (p *permissionsDesc) SetInterruptInheritance(interrupt bool) {
+	p.interruptInheritance = &interrupt
+}
+
 func (p *permissionsDesc) AppendAction(action *Ydb_Scheme.PermissionsAction) {
 	p.actions = append(p.actions, action)
 }
@@ -25,6 +25,7 @@ func TestSchemeOptions(t *testing.T) {
 				Subject:         "revoke",
 				PermissionNames: []string{"e"},
 			}),
+			scheme.WithInterruptInheritance(true),
 		}
 
 		var desc permissionsDesc
@@ -38,6 +39,10 @@ func TestSchemeOptions(t *testing.T) {
 			t.Errorf("Clear is not as expected")
 		}
 
+		if desc.interruptInheritance == nil || !*desc.interruptInheritance {
+			t.Errorf("InterruptInheritance is not as expected")
+		}
+
 		count := len(desc.actions)
 		for _, a := range desc.actions {
 			switch a := a.GetAction().(type) {
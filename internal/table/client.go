@@ -5,11 +5,13 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/jonboulle/clockwork"
 	"google.golang.org/grpc"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/drain"
 	metaHeaders "github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
@@ -98,6 +100,9 @@ type Client struct {
 	testHookGetWaitCh func() // nil except some tests.
 	wg                sync.WaitGroup
 	done              chan struct{}
+
+	// drain counts in-flight calls of Do and DoTx for graceful close
+	drain drain.Gate
 }
 
 type createSessionOptions struct {
@@ -626,6 +631,17 @@ func (c *Client) Close(ctx context.Context) (err error) {
 	return nil
 }
 
+// Drain rejects new calls of Do and DoTx and waits for in-flight calls.
+// Sessions are not closed, so in-flight calls can be retried
+func (c *Client) Drain(ctx context.Context) error {
+	return c.drain.Drain(ctx)
+}
+
+// Inflight returns count of in-flight calls of Do and DoTx
+func (c *Client) Inflight() int {
+	return c.drain.Inflight()
+}
+
 // Do provide the best effort for execute operation
 // Do implements internal busy loop until one of the following conditions is met:
 // - deadline was canceled or deadlined
@@ -640,6 +656,12 @@ func (c *Client) Do(ctx context.Context, op table.Operation, opts ...table.Optio
 		return xerrors.WithStackTrace(errClosedClient)
 	}
 
+	leave, err := c.drain.Enter()
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer leave()
+
 	config := c.retryOptions(opts...)
 
 	attempts, onIntermediate := 0, trace.TableOnDo(config.Trace, &ctx,
@@ -650,7 +672,7 @@ func (c *Client) Do(ctx context.Context, op table.Operation, opts ...table.Optio
 		onIntermediate(finalErr)(attempts, finalErr)
 	}()
 
-	err := do(ctx, c, c.config, op, func(err error) {
+	err = do(ctx, c, c.config, op, func(err error) {
 		attempts++
 		onIntermediate(err)
 	}, config.RetryOptions...)
@@ -670,19 +692,31 @@ func (c *Client) DoTx(ctx context.Context, op table.TxOperation, opts ...table.O
 		return xerrors.WithStackTrace(errClosedClient)
 	}
 
+	leave, err := c.drain.Enter()
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer leave()
+
 	config := c.retryOptions(opts...)
 
-	attempts, onIntermediate := 0, trace.TableOnDoTx(config.Trace, &ctx,
+	// attempts is atomic because attempts of hedged read-only transactions are concurrent
+	var attempts atomic.Int64
+	onIntermediate := trace.TableOnDoTx(config.Trace, &ctx,
 		stack.FunctionID(""),
 		config.Label, config.Label, config.Idempotent, xcontext.IsNestedCall(ctx),
 	)
 	defer func() {
-		onIntermediate(finalErr)(attempts, finalErr)
+		onIntermediate(finalErr)(int(attempts.Load()), finalErr)
 	}()
 
+	if isReadOnlyTx(config.TxSettings) {
+		ctx = xcontext.WithReadOnly(ctx)
+	}
+
 	return retryBackoff(ctx, c,
 		func(ctx context.Context, s table.Session) (err error) {
-			attempts++
+			attempts.Add(1)
 
 			defer func() {
 				onIntermediate(err)
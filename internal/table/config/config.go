@@ -6,6 +6,7 @@ import (
 	"github.com/jonboulle/clockwork"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -136,6 +137,13 @@ func WithIgnoreTruncated() Option {
 	}
 }
 
+// WithRetryPolicy replaces default retry policy of table client calls.
+func WithRetryPolicy(p retry.Policy) Option {
+	return func(c *Config) {
+		c.retryPolicy = p
+	}
+}
+
 // WithClock replaces default clock.
 func WithClock(clock clockwork.Clock) Option {
 	return func(c *Config) {
@@ -155,6 +163,8 @@ type Config struct {
 
 	ignoreTruncated bool
 
+	retryPolicy retry.Policy
+
 	trace *trace.Table
 
 	clock clockwork.Clock
@@ -233,6 +243,12 @@ func (c *Config) DeleteTimeout() time.Duration {
 	return c.deleteTimeout
 }
 
+// RetryPolicy is a retry policy of table client calls.
+// If RetryPolicy is nil then the default retry policy is used.
+func (c *Config) RetryPolicy() retry.Policy {
+	return c.retryPolicy
+}
+
 func defaults() *Config {
 	return &Config{
 		sizeLimit:            DefaultSessionPoolSizeLimit,
@@ -3,6 +3,8 @@ package table
 import (
 	"context"
 
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -80,7 +82,7 @@ func retryBackoff(
 			}()
 
 			if err = op(ctx, s); err != nil {
-				s.checkError(err)
+				s.checkError(ctx, err)
 
 				return xerrors.WithStackTrace(err)
 			}
@@ -91,6 +93,18 @@ func retryBackoff(
 	)
 }
 
+// isReadOnlyTx checks transaction settings for read-only transaction mode
+func isReadOnlyTx(settings *table.TransactionSettings) bool {
+	switch settings.Settings().GetTxMode().(type) {
+	case *Ydb_Table.TransactionSettings_OnlineReadOnly,
+		*Ydb_Table.TransactionSettings_StaleReadOnly,
+		*Ydb_Table.TransactionSettings_SnapshotReadOnly:
+		return true
+	default:
+		return false
+	}
+}
+
 func (c *Client) retryOptions(opts ...table.Option) *table.Options {
 	options := &table.Options{
 		Trace: c.config.Trace(),
@@ -99,6 +113,8 @@ func (c *Client) retryOptions(opts ...table.Option) *table.Options {
 		),
 		RetryOptions: []retry.Option{
 			retry.WithTrace(c.config.TraceRetry()),
+			retry.WithBudget(c.config.RetryBudget()),
+			retry.WithPolicy(c.config.RetryPolicy()),
 		},
 	}
 	for _, opt := range opts {
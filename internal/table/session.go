@@ -481,15 +481,19 @@ func (s *session) DropTable(
 	return xerrors.WithStackTrace(err)
 }
 
-func (s *session) checkError(err error) {
+func (s *session) checkError(ctx context.Context, err error) {
 	if err == nil {
 		return
 	}
-	if m := retry.Check(err); m.MustDeleteSession() {
+	if xcontext.MustDeleteSession(ctx, err, mustDeleteSession) {
 		s.SetStatus(table.SessionClosing)
 	}
 }
 
+func mustDeleteSession(err error) bool {
+	return retry.Check(err).MustDeleteSession()
+}
+
 // AlterTable modifies schema of table at given path with given options.
 func (s *session) AlterTable(
 	ctx context.Context,
@@ -2,16 +2,23 @@ package topicclientinternal
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Topic_V1"
 	"google.golang.org/grpc"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/drain"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawydb"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
@@ -25,6 +32,12 @@ type Client struct {
 	cred                   credentials.Credentials
 	defaultOperationParams rawydb.OperationParams
 	rawClient              rawtopic.Client
+
+	// drain counts started and not closed readers and writers for graceful close
+	drain   drain.Gate
+	mu      xsync.Mutex
+	readers map[int64]topicreaderinternal.Reader
+	writers map[*topicwriterinternal.Writer]struct{}
 }
 
 func New(
@@ -45,6 +58,8 @@ func New(
 		cred:                   cred,
 		defaultOperationParams: defaultOperationParams,
 		rawClient:              rawClient,
+		readers:                make(map[int64]topicreaderinternal.Reader),
+		writers:                make(map[*topicwriterinternal.Writer]struct{}),
 	}, nil
 }
 
@@ -66,6 +81,96 @@ func (c *Client) Close(_ context.Context) error {
 	return nil
 }
 
+// Drain rejects new readers and writers, flushes and closes started writers.
+// Started readers are not closed immediately: application may commit processed messages
+// and close readers until half of drain timeout, after that remaining readers are closed
+// with flush of commits
+func (c *Client) Drain(ctx context.Context) error {
+	c.drain.Close()
+
+	var writers []*topicwriterinternal.Writer
+	c.mu.WithLock(func() {
+		for w := range c.writers {
+			writers = append(writers, w)
+		}
+	})
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		issues []error
+	)
+	onError := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		issues = append(issues, err)
+	}
+	for _, w := range writers {
+		wg.Add(1)
+		go func(w *topicwriterinternal.Writer) {
+			defer wg.Done()
+
+			if err := w.Flush(ctx); err != nil {
+				onError(fmt.Errorf("flush of writer failed: %w", err))
+			}
+			if err := w.Close(ctx); err != nil {
+				onError(fmt.Errorf("close of writer failed: %w", err))
+			}
+		}(w)
+	}
+
+	// wait for readers closed by application, error is not interesting here:
+	// remaining readers are closed below
+	graceCtx, cancel := readersGrace(ctx)
+	_ = c.drain.Drain(graceCtx)
+	cancel()
+
+	var readers []topicreaderinternal.Reader
+	c.mu.WithLock(func() {
+		for _, r := range c.readers {
+			readers = append(readers, r)
+		}
+	})
+	for i := range readers {
+		wg.Add(1)
+		go func(r topicreaderinternal.Reader) {
+			defer wg.Done()
+
+			if err := r.Close(ctx); err != nil {
+				onError(fmt.Errorf("close of reader failed: %w", err))
+			}
+		}(readers[i])
+	}
+	wg.Wait()
+
+	if err := c.drain.Drain(ctx); err != nil {
+		issues = append(issues, err)
+	}
+
+	if len(issues) > 0 {
+		return xerrors.WithStackTrace(xerrors.NewWithIssues("topic drain failed", issues...))
+	}
+
+	return nil
+}
+
+// readersGrace returns context for wait of readers closed by application. If ctx has deadline -
+// half of remaining time is left for close of remaining readers
+func readersGrace(ctx context.Context) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return xcontext.WithCancel(ctx)
+	}
+
+	return xcontext.WithTimeout(ctx, time.Until(deadline)/2)
+}
+
+// Inflight returns count of started and not closed readers and writers
+func (c *Client) Inflight() int {
+	return c.drain.Inflight()
+}
+
 // Alter topic options.
 func (c *Client) Alter(ctx context.Context, path string, opts ...topicoptions.AlterOption) error {
 	req := &rawtopic.AlterTopicRequest{}
@@ -211,20 +316,61 @@ func (c *Client) StartReader(
 		return c.rawClient.StreamRead(ctx)
 	}
 
+	leave, err := c.drain.Enter()
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	onClose, register := c.trackReader(leave)
+
 	defaultOpts := []topicoptions.ReaderOption{
 		topicoptions.WithCommonConfig(c.cfg.Common),
 		topicreaderinternal.WithCredentials(c.cred),
 		topicreaderinternal.WithTrace(c.cfg.Trace),
 		topicoptions.WithReaderStartTimeout(topic.DefaultStartTimeout),
 	}
-	opts = append(defaultOpts, opts...)
+	opts = append(append(defaultOpts, opts...), topicreaderinternal.WithOnClose(onClose))
 
 	internalReader := topicreaderinternal.NewReader(connector, consumer, readSelectors, opts...)
 	trace.TopicOnReaderStart(internalReader.Tracer(), internalReader.ID(), consumer)
 
+	register(internalReader)
+
 	return topicreader.NewReader(internalReader), nil
 }
 
+// trackReader returns callbacks for close and registration of reader. Reader may be closed
+// before registration, so registration and close are serialized by c.mu
+func (c *Client) trackReader(leave func()) (onClose func(), register func(r topicreaderinternal.Reader)) {
+	var (
+		readerID   int64
+		registered bool
+		closed     bool
+	)
+
+	onClose = func() {
+		c.mu.WithLock(func() {
+			if !closed {
+				closed = true
+				if registered {
+					delete(c.readers, readerID)
+				}
+			}
+		})
+		leave()
+	}
+	register = func(r topicreaderinternal.Reader) {
+		c.mu.WithLock(func() {
+			if !closed {
+				readerID, registered = r.ID(), true
+				c.readers[readerID] = r
+			}
+		})
+	}
+
+	return onClose, register
+}
+
 // StartWriter create new topic writer wrapper.
 func (c *Client) StartWriter(topicPath string, opts ...topicoptions.WriterOption) (*topicwriter.Writer, error) {
 	var connector topicwriterinternal.ConnectFunc = func(ctx context.Context) (
@@ -241,12 +387,53 @@ func (c *Client) StartWriter(topicPath string, opts ...topicoptions.WriterOption
 		topicwriterinternal.WithTrace(c.cfg.Trace),
 	}
 
-	options = append(options, opts...)
+	leave, err := c.drain.Enter()
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	onClose, register := c.trackWriter(leave)
+	options = append(append(options, opts...), topicwriterinternal.WithOnClose(onClose))
 
 	writer, err := topicwriterinternal.NewWriter(c.cred, options)
 	if err != nil {
+		leave()
+
 		return nil, err
 	}
 
+	register(writer)
+
 	return topicwriter.NewWriter(writer), nil
 }
+
+// trackWriter returns callbacks for close and registration of writer. Writer may be closed
+// before registration, so registration and close are serialized by c.mu
+func (c *Client) trackWriter(leave func()) (onClose func(), register func(w *topicwriterinternal.Writer)) {
+	var (
+		registered *topicwriterinternal.Writer
+		closed     bool
+	)
+
+	onClose = func() {
+		c.mu.WithLock(func() {
+			if !closed {
+				closed = true
+				if registered != nil {
+					delete(c.writers, registered)
+				}
+			}
+		})
+		leave()
+	}
+	register = func(w *topicwriterinternal.Writer) {
+		c.mu.WithLock(func() {
+			if !closed {
+				registered = w
+				c.writers[registered] = struct{}{}
+			}
+		})
+	}
+
+	return onClose, register
+}
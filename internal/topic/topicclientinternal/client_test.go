@@ -0,0 +1,152 @@
+package topicclientinternal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/drain"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+)
+
+// unavailableConn rejects all calls, so readers and writers reconnect in background until close
+type unavailableConn struct{}
+
+func (unavailableConn) Invoke(context.Context, string, interface{}, interface{}, ...grpc.CallOption) error {
+	return grpcStatus.Error(grpcCodes.Unavailable, "unavailable")
+}
+
+func (unavailableConn) NewStream(
+	context.Context, *grpc.StreamDesc, string, ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	return nil, grpcStatus.Error(grpcCodes.Unavailable, "unavailable")
+}
+
+func newTestClient(t *testing.T) *Client {
+	c, err := New(xtest.Context(t), unavailableConn{}, nil)
+	require.NoError(t, err)
+
+	return c
+}
+
+func TestClientTrackClosedBeforeRegistration(t *testing.T) {
+	t.Run("Reader", func(t *testing.T) {
+		c := newTestClient(t)
+		leave, err := c.drain.Enter()
+		require.NoError(t, err)
+
+		onClose, register := c.trackReader(leave)
+		onClose()
+		register(topicreaderinternal.Reader{})
+		require.Empty(t, c.readers)
+		require.Equal(t, 0, c.Inflight())
+	})
+	t.Run("Writer", func(t *testing.T) {
+		c := newTestClient(t)
+		leave, err := c.drain.Enter()
+		require.NoError(t, err)
+
+		onClose, register := c.trackWriter(leave)
+		onClose()
+		register(&topicwriterinternal.Writer{})
+		require.Empty(t, c.writers)
+		require.Equal(t, 0, c.Inflight())
+	})
+}
+
+func TestClientDoubleClose(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := newTestClient(t)
+
+	reader, err := c.StartReader("consumer", topicoptions.ReadSelectors{{Path: "topic"}})
+	require.NoError(t, err)
+	writer, err := c.StartWriter("topic")
+	require.NoError(t, err)
+	require.Equal(t, 2, c.Inflight())
+	require.Len(t, c.readers, 1)
+	require.Len(t, c.writers, 1)
+
+	_ = reader.Close(ctx)
+	_ = reader.Close(ctx)
+	_ = writer.Close(ctx)
+	_ = writer.Close(ctx)
+	require.Equal(t, 0, c.Inflight())
+	require.Empty(t, c.readers)
+	require.Empty(t, c.writers)
+
+	// next readers are counted after double close of previous readers
+	reader, err = c.StartReader("consumer", topicoptions.ReadSelectors{{Path: "topic"}})
+	require.NoError(t, err)
+	require.Equal(t, 1, c.Inflight())
+	_ = reader.Close(ctx)
+	require.Equal(t, 0, c.Inflight())
+}
+
+func TestClientDrain(t *testing.T) {
+	t.Run("ReadersClosedByApplication", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		c := newTestClient(t)
+
+		reader, err := c.StartReader("consumer", topicoptions.ReadSelectors{{Path: "topic"}})
+		require.NoError(t, err)
+
+		drainCtx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		drained := make(chan error, 1)
+		go func() {
+			drained <- c.Drain(drainCtx)
+		}()
+
+		// new readers and writers are rejected after start of drain
+		require.Eventually(t, func() bool {
+			_, err := c.StartReader("consumer", topicoptions.ReadSelectors{{Path: "topic"}})
+
+			return errors.Is(err, drain.ErrClosing)
+		}, time.Second, time.Millisecond)
+		_, err = c.StartWriter("topic")
+		require.ErrorIs(t, err, drain.ErrClosing)
+
+		// reader is not closed by drain during grace period
+		select {
+		case err := <-drained:
+			t.Fatalf("drain finished before close of reader: %v", err)
+		case <-time.After(10 * time.Millisecond):
+		}
+		require.Len(t, c.readers, 1)
+
+		// drain finishes on close of reader by application without waiting for end of grace period
+		_ = reader.Close(ctx)
+		select {
+		case err := <-drained:
+			require.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("drain not finished after close of reader")
+		}
+		require.Equal(t, 0, c.Inflight())
+	})
+	t.Run("RemainingReadersClosedByDrain", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		c := newTestClient(t)
+
+		_, err := c.StartReader("consumer", topicoptions.ReadSelectors{{Path: "topic"}})
+		require.NoError(t, err)
+		_, err = c.StartWriter("topic")
+		require.NoError(t, err)
+
+		drainCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		_ = c.Drain(drainCtx)
+		require.Equal(t, 0, c.Inflight())
+		require.Empty(t, c.readers)
+		require.Empty(t, c.writers)
+	})
+}
@@ -125,6 +125,16 @@ func (m *PublicBatch) getCommitRange() PublicCommitRange {
 	return m.commitRange.getCommitRange()
 }
 
+// bufferBytesAccount return size of the batch messages in reader buffer
+func (m *PublicBatch) bufferBytesAccount() int {
+	size := 0
+	for i := range m.Messages {
+		size += m.Messages[i].bufferBytesAccount
+	}
+
+	return size
+}
+
 func (m *PublicBatch) append(b *PublicBatch) (*PublicBatch, error) {
 	var res *PublicBatch
 	if m == nil {
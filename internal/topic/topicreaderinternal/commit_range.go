@@ -165,6 +165,39 @@ type PublicCommitRange struct {
 	priv commitRange
 }
 
+// PublicGetCommitRange return commit range of message or batch
+func PublicGetCommitRange(obj PublicCommitRangeGetter) PublicCommitRange {
+	return obj.getCommitRange()
+}
+
+// Topic of committed messages
+func (r PublicCommitRange) Topic() string {
+	if r.priv.partitionSession == nil {
+		return ""
+	}
+
+	return r.priv.partitionSession.Topic
+}
+
+// PartitionID of committed messages
+func (r PublicCommitRange) PartitionID() int64 {
+	if r.priv.partitionSession == nil {
+		return 0
+	}
+
+	return r.priv.partitionSession.PartitionID
+}
+
+// StartOffset is offset of first message of the range
+func (r PublicCommitRange) StartOffset() int64 {
+	return r.priv.commitOffsetStart.ToInt64()
+}
+
+// EndOffset is offset of next message after the range, read of the partition must be continued from the offset
+func (r PublicCommitRange) EndOffset() int64 {
+	return r.priv.commitOffsetEnd.ToInt64()
+}
+
 type commitRange struct {
 	commitOffsetStart rawtopicreader.Offset
 	commitOffsetEnd   rawtopicreader.Offset
@@ -167,6 +167,8 @@ func (pmb *PublicMessageBuilder) WriteSessionMetadata(writeSessionMetadata map[s
 // Offset set message Offset.
 func (pmb *PublicMessageBuilder) Offset(offset int64) *PublicMessageBuilder {
 	pmb.mess.Offset = offset
+	pmb.mess.commitRange.commitOffsetStart.FromInt64(offset)
+	pmb.mess.commitRange.commitOffsetEnd.FromInt64(offset + 1)
 
 	return pmb
 }
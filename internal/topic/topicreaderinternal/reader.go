@@ -48,6 +48,9 @@ type Reader struct {
 	defaultBatchConfig ReadMessageBatchOptions
 	tracer             *trace.Topic
 	readerID           int64
+	seekState          *readerSeekState
+	restartStream      func(ctx context.Context) error
+	onClose            func()
 }
 
 type ReadMessageBatchOptions struct {
@@ -84,6 +87,7 @@ func NewReader(
 	opts ...PublicReaderOption,
 ) Reader {
 	cfg := convertNewParamsToStreamConfig(consumer, readSelectors, opts...)
+	cfg.seekState = newReaderSeekState()
 	readerID := nextReaderID()
 
 	readerConnector := func(ctx context.Context) (batchedStreamReader, error) {
@@ -95,18 +99,30 @@ func NewReader(
 		return newTopicStreamReader(readerID, stream, cfg.topicStreamReaderConfig)
 	}
 
+	topics := make([]string, 0, len(cfg.ReadSelectors))
+	for _, selector := range cfg.ReadSelectors {
+		topics = append(topics, selector.Path)
+	}
+
+	reconnector := newReaderReconnector(
+		readerID,
+		consumer,
+		topics,
+		readerConnector,
+		cfg.OperationTimeout(),
+		cfg.RetrySettings,
+		cfg.Trace,
+		cfg.BaseContext,
+	)
+
 	res := Reader{
-		reader: newReaderReconnector(
-			readerID,
-			readerConnector,
-			cfg.OperationTimeout(),
-			cfg.RetrySettings,
-			cfg.Trace,
-			cfg.BaseContext,
-		),
+		reader:             reconnector,
 		defaultBatchConfig: cfg.DefaultBatchConfig,
 		tracer:             cfg.Trace,
 		readerID:           readerID,
+		seekState:          cfg.seekState,
+		restartStream:      reconnector.restartStream,
+		onClose:            cfg.onClose,
 	}
 
 	return res
@@ -125,6 +141,10 @@ func (r *Reader) Tracer() *trace.Topic {
 }
 
 func (r *Reader) Close(ctx context.Context) error {
+	if r.onClose != nil {
+		defer r.onClose()
+	}
+
 	return r.reader.CloseWithError(ctx, xerrors.WithStackTrace(errReaderClosed))
 }
 
@@ -219,12 +239,44 @@ func (r *Reader) CommitRanges(ctx context.Context, ranges []PublicCommitRange) e
 	return nil
 }
 
+// SeekPartition move read position of the partition to the offset.
+// Read stream will be restarted: buffered messages will be dropped and partition sessions will be started again.
+func (r *Reader) SeekPartition(ctx context.Context, topic string, partitionID int64, offset int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if offset < 0 {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: seek to negative offset: %v", offset))
+	}
+
+	r.seekState.SeekOffset(topic, partitionID, offset)
+
+	return r.restartStream(ctx)
+}
+
+// SeekTime skip messages written before the time in all partitions of the reader.
+// Read stream will be restarted: buffered messages will be dropped and partition sessions will be started again.
+func (r *Reader) SeekTime(ctx context.Context, readFrom time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if readFrom.IsZero() {
+		return xerrors.WithStackTrace(errors.New("ydb: seek to zero time"))
+	}
+
+	r.seekState.SeekTime(readFrom)
+
+	return r.restartStream(ctx)
+}
+
 type ReaderConfig struct {
 	config.Common
 
 	RetrySettings      topic.RetrySettings
 	DefaultBatchConfig ReadMessageBatchOptions
 	topicStreamReaderConfig
+
+	onClose func()
 }
 
 type PublicReaderOption func(cfg *ReaderConfig)
@@ -238,6 +290,13 @@ func WithCredentials(cred credentials.Credentials) PublicReaderOption {
 	}
 }
 
+// WithOnClose defines callback, which called after close of reader
+func WithOnClose(onClose func()) PublicReaderOption {
+	return func(cfg *ReaderConfig) {
+		cfg.onClose = onClose
+	}
+}
+
 func WithTrace(tracer *trace.Topic) PublicReaderOption {
 	return func(cfg *ReaderConfig) {
 		cfg.Trace = cfg.Trace.Compose(tracer)
@@ -0,0 +1,99 @@
+package topicreaderinternal
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// errReaderSeek is retriable - it used for close current stream and reconnect with new read positions
+var errReaderSeek = xerrors.Retryable(xerrors.Wrap(errors.New("ydb: restart reader stream for seek")))
+
+type topicPartition struct {
+	topic       string
+	partitionID int64
+}
+
+// readerSeekState store seek requests between reconnects of the reader
+// it shared between all streams of one reader.
+type readerSeekState struct {
+	m sync.Mutex
+
+	offsets  map[topicPartition]rawtopicreader.Offset
+	readFrom time.Time
+}
+
+func newReaderSeekState() *readerSeekState {
+	return &readerSeekState{
+		offsets: make(map[topicPartition]rawtopicreader.Offset),
+	}
+}
+
+// SeekOffset set read position for the partition, it will be applied on next start of the partition session
+func (s *readerSeekState) SeekOffset(topic string, partitionID int64, offset int64) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	var o rawtopicreader.Offset
+	o.FromInt64(offset)
+	s.offsets[topicPartition{topic: topic, partitionID: partitionID}] = o
+}
+
+// SeekTime set read from time for all next streams of the reader. Previous seek requests are cancelled.
+func (s *readerSeekState) SeekTime(readFrom time.Time) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.offsets = make(map[topicPartition]rawtopicreader.Offset)
+	s.readFrom = readFrom
+}
+
+// ReadFrom return read from time of seek or zero time if time seek wasn't requested.
+// The time is sent in init request of each stream, so it not lost on reconnects
+func (s *readerSeekState) ReadFrom() time.Time {
+	if s == nil {
+		return time.Time{}
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.readFrom
+}
+
+// startPosition return position for start partition session
+// byOffset - true if read position was set by offset seek
+func (s *readerSeekState) startPosition(topic string, partitionID int64) (
+	offset rawtopicreader.Offset,
+	byOffset bool,
+) {
+	if s == nil {
+		return offset, false
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	offset, byOffset = s.offsets[topicPartition{topic: topic, partitionID: partitionID}]
+
+	return offset, byOffset
+}
+
+// applied must be called after start partition response sent with position from startPosition.
+func (s *readerSeekState) applied(topic string, partitionID int64, offset rawtopicreader.Offset) {
+	if s == nil {
+		return
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	key := topicPartition{topic: topic, partitionID: partitionID}
+	// the partition may be seek again while start
+	if current, ok := s.offsets[key]; ok && current == offset {
+		delete(s.offsets, key)
+	}
+}
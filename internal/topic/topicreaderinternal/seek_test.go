@@ -0,0 +1,87 @@
+package topicreaderinternal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+)
+
+func TestReaderSeekState(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		var s *readerSeekState
+		_, byOffset := s.startPosition("topic", 1)
+		require.False(t, byOffset)
+		require.True(t, s.ReadFrom().IsZero())
+	})
+	t.Run("Offset", func(t *testing.T) {
+		s := newReaderSeekState()
+		s.SeekOffset("topic", 1, 10)
+
+		offset, byOffset := s.startPosition("topic", 1)
+		require.Equal(t, rawtopicreader.NewOffset(10), offset)
+		require.True(t, byOffset)
+
+		_, otherByOffset := s.startPosition("topic", 2)
+		require.False(t, otherByOffset)
+
+		s.applied("topic", 1, offset)
+		_, byOffset = s.startPosition("topic", 1)
+		require.False(t, byOffset)
+	})
+	t.Run("OffsetChangedWhileApply", func(t *testing.T) {
+		s := newReaderSeekState()
+		s.SeekOffset("topic", 1, 10)
+		offset, _ := s.startPosition("topic", 1)
+		s.SeekOffset("topic", 1, 20)
+		s.applied("topic", 1, offset)
+
+		offset, byOffset := s.startPosition("topic", 1)
+		require.True(t, byOffset)
+		require.Equal(t, rawtopicreader.NewOffset(20), offset)
+	})
+	t.Run("Time", func(t *testing.T) {
+		readFrom := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		s := newReaderSeekState()
+		s.SeekOffset("topic", 1, 10)
+		s.SeekTime(readFrom)
+
+		// time seek cancel offset seeks and not force read offset of partitions
+		_, byOffset := s.startPosition("topic", 1)
+		require.False(t, byOffset)
+
+		// read from time kept for all next streams
+		require.Equal(t, readFrom, s.ReadFrom())
+		require.Equal(t, readFrom, s.ReadFrom())
+	})
+}
+
+func TestTopicStreamReaderConfig_InitMessageWithSeek(t *testing.T) {
+	selectorReadFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	seekReadFrom := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cfg := newTopicStreamReaderConfig()
+	cfg.ReadSelectors = []*PublicReadSelector{
+		{Path: "first"},
+		{Path: "second", ReadFrom: selectorReadFrom},
+	}
+	cfg.seekState = newReaderSeekState()
+
+	msg := cfg.initMessage()
+	require.False(t, msg.TopicsReadSettings[0].ReadFrom.HasValue)
+	require.Equal(t, selectorReadFrom, msg.TopicsReadSettings[1].ReadFrom.Value)
+
+	cfg.seekState.SeekTime(seekReadFrom)
+	msg = cfg.initMessage()
+	for i := range msg.TopicsReadSettings {
+		require.True(t, msg.TopicsReadSettings[i].ReadFrom.HasValue)
+		require.Equal(t, seekReadFrom, msg.TopicsReadSettings[i].ReadFrom.Value)
+	}
+
+	// stream after reconnect read from the time too
+	msg = cfg.initMessage()
+	require.Equal(t, seekReadFrom, msg.TopicsReadSettings[0].ReadFrom.Value)
+}
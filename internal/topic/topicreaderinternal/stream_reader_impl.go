@@ -68,6 +68,8 @@ type topicStreamReaderConfig struct {
 	GetPartitionStartOffsetCallback PublicGetPartitionStartOffsetFunc
 	CommitMode                      PublicCommitMode
 	Decoders                        decoderMap
+
+	seekState *readerSeekState
 }
 
 func newTopicStreamReaderConfig() topicStreamReaderConfig {
@@ -88,6 +90,10 @@ func (cfg *topicStreamReaderConfig) initMessage() *rawtopicreader.InitRequest {
 		Consumer: cfg.Consumer,
 	}
 
+	// time seek is applied by server: partitions are read from committed offset,
+	// messages written before the time are skipped
+	seekReadFrom := cfg.seekState.ReadFrom()
+
 	res.TopicsReadSettings = make([]rawtopicreader.TopicReadSettings, len(cfg.ReadSelectors))
 	for i, selector := range cfg.ReadSelectors {
 		settings := &res.TopicsReadSettings[i]
@@ -97,6 +103,10 @@ func (cfg *topicStreamReaderConfig) initMessage() *rawtopicreader.InitRequest {
 			settings.ReadFrom.HasValue = true
 			settings.ReadFrom.Value = selector.ReadFrom
 		}
+		if !seekReadFrom.IsZero() {
+			settings.ReadFrom.HasValue = true
+			settings.ReadFrom.Value = seekReadFrom
+		}
 		if selector.MaxTimeLag != 0 {
 			settings.MaxLag.HasValue = true
 			settings.MaxLag.Value = selector.MaxTimeLag
@@ -184,7 +194,7 @@ func (r *topicStreamReaderImpl) ReadMessageBatch(
 	)
 	defer func() {
 		if batch == nil {
-			onDone(0, "", -1, -1, -1, -1, r.getRestBufferBytes(), err)
+			onDone(0, "", -1, -1, -1, -1, r.getRestBufferBytes(), r.cfg.Consumer, 0, err)
 		} else {
 			onDone(
 				len(batch.Messages),
@@ -194,6 +204,8 @@ func (r *topicStreamReaderImpl) ReadMessageBatch(
 				batch.commitRange.commitOffsetStart.ToInt64(),
 				batch.commitRange.commitOffsetEnd.ToInt64(),
 				r.getRestBufferBytes(),
+				r.cfg.Consumer,
+				batch.bufferBytesAccount(),
 				err,
 			)
 		}
@@ -302,6 +314,7 @@ func (r *topicStreamReaderImpl) onStopPartitionSessionRequestFromBuffer(
 		session.partitionSessionID.ToInt64(),
 		msg.CommittedOffset.ToInt64(),
 		msg.Graceful,
+		r.cfg.Consumer,
 	)
 	defer func() {
 		onDone(err)
@@ -361,6 +374,7 @@ func (r *topicStreamReaderImpl) Commit(ctx context.Context, commitRange commitRa
 		session.partitionSessionID.ToInt64(),
 		commitRange.commitOffsetStart.ToInt64(),
 		commitRange.commitOffsetEnd.ToInt64(),
+		r.cfg.Consumer,
 	)
 	defer func() {
 		onDone(err)
@@ -588,12 +602,8 @@ func (r *topicStreamReaderImpl) sendDataRequest(size int) error {
 }
 
 func (r *topicStreamReaderImpl) freeBufferFromMessages(batch *PublicBatch) {
-	size := 0
-	for messageIndex := range batch.Messages {
-		size += batch.Messages[messageIndex].bufferBytesAccount
-	}
 	select {
-	case r.freeBytes <- size:
+	case r.freeBytes <- batch.bufferBytesAccount():
 	case <-r.ctx.Done():
 	}
 }
@@ -773,6 +783,7 @@ func (r *topicStreamReaderImpl) onStartPartitionSessionRequestFromBuffer(
 		session.Topic,
 		session.PartitionID,
 		session.partitionSessionID.ToInt64(),
+		r.cfg.Consumer,
 	)
 
 	respMessage := &rawtopicreader.StartPartitionSessionResponse{
@@ -801,13 +812,37 @@ func (r *topicStreamReaderImpl) onStartPartitionSessionRequestFromBuffer(
 		}
 	}
 
+	seekOffset, seekByOffset := r.cfg.seekState.startPosition(session.Topic, session.PartitionID)
+	if seekByOffset {
+		wantOffset := seekOffset.ToInt64()
+		forceOffset = &wantOffset
+	}
+
 	respMessage.ReadOffset.FromInt64Pointer(forceOffset)
 	if r.cfg.CommitMode.commitsEnabled() {
 		commitOffset = forceOffset
 		respMessage.CommitOffset.FromInt64Pointer(commitOffset)
 	}
 
-	return r.send(respMessage)
+	if forceOffset != nil {
+		// messages will be received from the offset, need to sync commit ranges with it
+		var readOffset rawtopicreader.Offset
+		readOffset.FromInt64(*forceOffset)
+		session.setLastReceivedMessageOffset(readOffset - 1)
+		if commitOffset != nil {
+			session.setCommittedOffset(readOffset)
+		}
+	}
+
+	if err = r.send(respMessage); err != nil {
+		return err
+	}
+
+	if seekByOffset {
+		r.cfg.seekState.applied(session.Topic, session.PartitionID, seekOffset)
+	}
+
+	return nil
 }
 
 func (r *topicStreamReaderImpl) onStopPartitionSessionRequest(m *rawtopicreader.StopPartitionSessionRequest) error {
@@ -324,6 +324,77 @@ func TestTopicStreamReaderImpl_CommitStolen(t *testing.T) {
 	})
 }
 
+func TestTopicStreamReaderImpl_StartPartitionWithSeek(t *testing.T) {
+	xtest.TestManyTimesWithName(t, "Offset", func(t testing.TB) {
+		e := newTopicReaderTestEnv(t)
+		e.reader.cfg.seekState = newReaderSeekState()
+		e.reader.cfg.seekState.SeekOffset("/test", 6, 10)
+		e.Start()
+
+		readCtx, readCtxCancel := xcontext.WithCancel(e.ctx)
+		e.stream.EXPECT().Send(&rawtopicreader.StartPartitionSessionResponse{
+			PartitionSessionID: 16,
+			ReadOffset:         rawtopicreader.OptionalOffset{Offset: 10, HasValue: true},
+			CommitOffset:       rawtopicreader.OptionalOffset{Offset: 10, HasValue: true},
+		}).Return(nil).Do(func(_ interface{}) {
+			readCtxCancel()
+		})
+
+		e.SendFromServer(&rawtopicreader.StartPartitionSessionRequest{
+			PartitionSession: rawtopicreader.PartitionSession{
+				PartitionSessionID: 16,
+				Path:               "/test",
+				PartitionID:        6,
+			},
+			CommittedOffset: 30,
+		})
+
+		_, err := e.reader.ReadMessageBatch(readCtx, newReadMessageBatchOptions())
+		require.ErrorIs(t, err, context.Canceled)
+
+		session, err := e.reader.sessionController.Get(16)
+		require.NoError(t, err)
+		require.Equal(t, rawtopicreader.NewOffset(10), session.committedOffset())
+		require.Equal(t, rawtopicreader.NewOffset(9), session.lastReceivedMessageOffset())
+
+		_, byOffset := e.reader.cfg.seekState.startPosition("/test", 6)
+		require.False(t, byOffset)
+	})
+	xtest.TestManyTimesWithName(t, "Time", func(t testing.TB) {
+		e := newTopicReaderTestEnv(t)
+		e.reader.cfg.seekState = newReaderSeekState()
+		e.reader.cfg.seekState.SeekTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+		e.Start()
+
+		// time seek applied by read from of init request, read offset is not forced
+		readCtx, readCtxCancel := xcontext.WithCancel(e.ctx)
+		e.stream.EXPECT().Send(&rawtopicreader.StartPartitionSessionResponse{
+			PartitionSessionID: 16,
+			ReadOffset:         rawtopicreader.OptionalOffset{Offset: -1},
+			CommitOffset:       rawtopicreader.OptionalOffset{Offset: -1},
+		}).Return(nil).Do(func(_ interface{}) {
+			readCtxCancel()
+		})
+
+		e.SendFromServer(&rawtopicreader.StartPartitionSessionRequest{
+			PartitionSession: rawtopicreader.PartitionSession{
+				PartitionSessionID: 16,
+				Path:               "/test",
+				PartitionID:        6,
+			},
+			CommittedOffset: 30,
+		})
+
+		_, err := e.reader.ReadMessageBatch(readCtx, newReadMessageBatchOptions())
+		require.ErrorIs(t, err, context.Canceled)
+
+		session, err := e.reader.sessionController.Get(16)
+		require.NoError(t, err)
+		require.Equal(t, rawtopicreader.NewOffset(30), session.committedOffset())
+		require.False(t, e.reader.cfg.seekState.ReadFrom().IsZero())
+	})
+}
+
 func TestTopicStreamReaderImpl_Create(t *testing.T) {
 	xtest.TestManyTimesWithName(t, "BadSessionInitialization", func(t testing.TB) {
 		mc := gomock.NewController(t)
@@ -42,6 +42,8 @@ type readerReconnector struct {
 	reconnectFromBadStream     chan reconnectRequest
 	connectTimeout             time.Duration
 	readerID                   int64
+	consumer                   string
+	topics                     []string
 	streamConnectionInProgress empty.Chan // opened if connection in progress, closed if connection established
 	initDoneCh                 empty.Chan
 	m                          xsync.RWMutex
@@ -52,6 +54,8 @@ type readerReconnector struct {
 //nolint:revive
 func newReaderReconnector(
 	readerID int64,
+	consumer string,
+	topics []string,
 	connector readerConnectFunc,
 	connectTimeout time.Duration,
 	retrySettings topic.RetrySettings,
@@ -60,6 +64,8 @@ func newReaderReconnector(
 ) *readerReconnector {
 	res := &readerReconnector{
 		readerID:       readerID,
+		consumer:       consumer,
+		topics:         topics,
 		clock:          clockwork.NewRealClock(),
 		readerConnect:  connector,
 		streamErr:      errUnconnected,
@@ -141,6 +147,31 @@ func (r *readerReconnector) Commit(ctx context.Context, commitRange commitRange)
 	return err
 }
 
+// restartStream close current stream and request reconnect with new stream, it not waits the reconnect.
+// All partition sessions of the current stream will be stopped and buffered messages will be dropped.
+func (r *readerReconnector) restartStream(ctx context.Context) error {
+	stream, err := r.stream(ctx)
+	if err != nil && !r.isRetriableError(err) {
+		return err
+	}
+
+	if stream != nil {
+		// close stream before reconnect for guarantee no messages will be read from old stream
+		_ = stream.CloseWithError(ctx, xerrors.WithStackTrace(errReaderSeek))
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-r.background.Done():
+		return r.closedErr
+	case r.reconnectFromBadStream <- newReconnectRequest(stream, nil):
+		trace.TopicOnReaderReconnectRequest(r.tracer, errReaderSeek, true)
+
+		return nil
+	}
+}
+
 func (r *readerReconnector) CloseWithError(ctx context.Context, err error) error {
 	var closeErr error
 	r.closeOnce.Do(func() {
@@ -238,7 +269,7 @@ func (r *readerReconnector) reconnectionLoop(ctx context.Context) {
 }
 
 func (r *readerReconnector) reconnect(ctx context.Context, reason error, oldReader batchedStreamReader) (err error) {
-	onDone := trace.TopicOnReaderReconnect(r.tracer, reason)
+	onDone := trace.TopicOnReaderReconnect(r.tracer, reason, r.consumer, r.topics)
 	defer func() {
 		onDone(err)
 	}()
@@ -485,3 +485,58 @@ func readerConnectFuncMock(answers ...readerConnectFuncAnswer) readerConnectFunc
 		return res.callback(ctx)
 	}
 }
+
+func TestTopicReaderReconnectorRestartStream(t *testing.T) {
+	xtest.TestManyTimes(t, func(t testing.TB) {
+		mc := gomock.NewController(t)
+		ctx := xtest.Context(t)
+
+		opts := ReadMessageBatchOptions{batcherGetOptions: batcherGetOptions{MaxCount: 10}}
+
+		baseReader1 := NewMockbatchedStreamReader(mc)
+		var firstCloseReason error
+		baseReader1.EXPECT().CloseWithError(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, err error) error {
+				if firstCloseReason == nil {
+					firstCloseReason = err
+				}
+
+				return nil
+			}).MinTimes(1)
+		// closed stream return close reason
+		baseReader1.EXPECT().ReadMessageBatch(gomock.Any(), opts).Return(nil, errReaderSeek).AnyTimes()
+
+		baseReader2 := NewMockbatchedStreamReader(mc)
+		batch := &PublicBatch{
+			Messages: []*PublicMessage{{WrittenAt: time.Date(2022, 0o6, 15, 17, 56, 0, 0, time.UTC)}},
+		}
+		baseReader2.EXPECT().ReadMessageBatch(gomock.Any(), opts).Return(batch, nil)
+		baseReader2.EXPECT().CloseWithError(gomock.Any(), gomock.Any()).Return(nil)
+
+		readers := []batchedStreamReader{baseReader1, baseReader2}
+		connectCalled := 0
+		reader := &readerReconnector{
+			readerConnect: func(ctx context.Context) (batchedStreamReader, error) {
+				connectCalled++
+
+				return readers[connectCalled-1], nil
+			},
+			streamErr: errUnconnected,
+			tracer:    &trace.Topic{},
+		}
+		reader.initChannelsAndClock()
+		reader.start()
+		defer func() {
+			_ = reader.CloseWithError(ctx, errors.New("test finished"))
+		}()
+		require.NoError(t, reader.WaitInit(ctx))
+
+		require.NoError(t, reader.restartStream(ctx))
+		require.ErrorIs(t, firstCloseReason, errReaderSeek)
+
+		res, err := reader.ReadMessageBatch(ctx, opts)
+		require.NoError(t, err)
+		require.Equal(t, batch, res)
+		require.Equal(t, 2, connectCalled)
+	})
+}
@@ -0,0 +1,135 @@
+package topicwriterinternal
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type PublicWriteAckStatus int
+
+const (
+	PublicWriteAckStatusUnknown PublicWriteAckStatus = iota
+
+	// PublicWriteAckStatusWritten - message was written to the topic, offset is valid
+	PublicWriteAckStatusWritten
+
+	// PublicWriteAckStatusSkipped - message was skipped by server, for example it was deduplicated by seqno
+	PublicWriteAckStatusSkipped
+)
+
+func (s PublicWriteAckStatus) String() string {
+	switch s {
+	case PublicWriteAckStatusWritten:
+		return "Written"
+	case PublicWriteAckStatusSkipped:
+		return "Skipped"
+	default:
+		return "Unknown"
+	}
+}
+
+// PublicWriteAck is server acknowledgement of one written message
+type PublicWriteAck struct {
+	SeqNo       int64
+	PartitionID int64
+
+	// Offset of the message in partition. Valid for PublicWriteAckStatusWritten only.
+	Offset int64
+	Status PublicWriteAckStatus
+}
+
+func newPublicWriteAck(partitionID int64, ack *rawtopicwriter.WriteAck) PublicWriteAck {
+	res := PublicWriteAck{
+		SeqNo:       ack.SeqNo,
+		PartitionID: partitionID,
+	}
+
+	switch ack.MessageWriteStatus.Type {
+	case rawtopicwriter.WriteStatusTypeWritten:
+		res.Status = PublicWriteAckStatusWritten
+		res.Offset = ack.MessageWriteStatus.WrittenOffset
+	case rawtopicwriter.WriteStatusTypeSkipped:
+		res.Status = PublicWriteAckStatusSkipped
+	default:
+		res.Status = PublicWriteAckStatusUnknown
+	}
+
+	return res
+}
+
+// PublicWriteAckFuture will complete after all messages of one write call receive acks from server
+// or the writer closed.
+type PublicWriteAckFuture struct {
+	done empty.Chan
+
+	// fields below protected by mutex of message queue and may be read without lock after done closed
+	acks    []PublicWriteAck
+	waitFor int
+	err     error
+}
+
+func newPublicWriteAckFuture(messagesCount int) *PublicWriteAckFuture {
+	f := &PublicWriteAckFuture{
+		done:    make(empty.Chan),
+		acks:    make([]PublicWriteAck, messagesCount),
+		waitFor: messagesCount,
+	}
+	if messagesCount == 0 {
+		close(f.done)
+	}
+
+	return f
+}
+
+// Done return channel, which closed after all acks received or writer closed
+func (f *PublicWriteAckFuture) Done() <-chan struct{} {
+	return f.done
+}
+
+// Wait acks for all messages of the write call.
+// Acks returned in same order as messages was passed to write.
+func (f *PublicWriteAckFuture) Wait(ctx context.Context) ([]PublicWriteAck, error) {
+	select {
+	case <-ctx.Done():
+		return nil, xerrors.WithStackTrace(ctx.Err())
+	case <-f.done:
+		if f.err != nil {
+			return nil, f.err
+		}
+
+		return f.acks, nil
+	}
+}
+
+func (f *PublicWriteAckFuture) ackReceivedNeedLock(index int, ack PublicWriteAck) {
+	if f.isDone() {
+		return
+	}
+
+	f.acks[index] = ack
+	f.waitFor--
+	if f.waitFor == 0 {
+		close(f.done)
+	}
+}
+
+func (f *PublicWriteAckFuture) closeNeedLock(err error) {
+	if f.isDone() {
+		return
+	}
+
+	f.err = err
+	close(f.done)
+}
+
+func (f *PublicWriteAckFuture) isDone() bool {
+	select {
+	case <-f.done:
+		return true
+	default:
+		return false
+	}
+}
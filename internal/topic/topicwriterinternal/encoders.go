@@ -78,6 +78,7 @@ type EncoderSelector struct {
 	tracer              *trace.Topic
 	writerReconnectorID string
 	sessionID           string
+	topic               string
 
 	allowedCodecs          rawtopiccommon.SupportedCodecs
 	lastSelectedCodec      rawtopiccommon.Codec
@@ -91,7 +92,7 @@ func NewEncoderSelector(
 	allowedCodecs rawtopiccommon.SupportedCodecs,
 	parallelCompressors int,
 	tracer *trace.Topic,
-	writerReconnectorID, sessionID string,
+	writerReconnectorID, sessionID, topic string,
 ) EncoderSelector {
 	if parallelCompressors <= 0 {
 		panic("ydb: need leas one allowed compressor")
@@ -104,6 +105,7 @@ func NewEncoderSelector(
 		tracer:                 tracer,
 		writerReconnectorID:    writerReconnectorID,
 		sessionID:              sessionID,
+		topic:                  topic,
 	}
 	res.ResetAllowedCodecs(allowedCodecs)
 
@@ -121,6 +123,7 @@ func (s *EncoderSelector) CompressMessages(messages []messageWithDataContent) (r
 			messages[0].SeqNo,
 			len(messages),
 			trace.TopicWriterCompressMessagesReasonCompressData,
+			s.topic,
 		)
 		err = cacheMessages(messages, codec, s.parallelCompressors)
 		onCompressDone(err)
@@ -191,6 +194,7 @@ func (s *EncoderSelector) measureCodecs(messages []messageWithDataContent) (rawt
 			firstSeqNo,
 			len(messages),
 			trace.TopicWriterCompressMessagesReasonCodecsMeasure,
+			s.topic,
 		)
 		err := cacheMessages(messages, codec, s.parallelCompressors)
 		onCompressDone(err)
@@ -14,7 +14,7 @@ import (
 
 func TestEncoderSelector_CodecMeasure(t *testing.T) {
 	t.Run("Empty", func(t *testing.T) {
-		s := NewEncoderSelector(testCommonEncoders, nil, 1, &trace.Topic{}, "", "")
+		s := NewEncoderSelector(testCommonEncoders, nil, 1, &trace.Topic{}, "", "", "")
 		_, err := s.measureCodecs(nil)
 		require.Error(t, err)
 	})
@@ -26,6 +26,7 @@ func TestEncoderSelector_CodecMeasure(t *testing.T) {
 			&trace.Topic{},
 			"",
 			"",
+			"",
 		)
 		codec, err := s.measureCodecs(nil)
 		require.NoError(t, err)
@@ -45,7 +46,7 @@ func TestEncoderSelector_CodecMeasure(t *testing.T) {
 				rawtopiccommon.CodecRaw,
 				rawtopiccommon.CodecGzip,
 			}, 4,
-				&trace.Topic{}, "", "",
+				&trace.Topic{}, "", "", "",
 			)
 
 			var messages []messageWithDataContent
@@ -66,6 +66,9 @@ type messageWithDataContent struct {
 	rawBuf              bytes.Buffer
 	encoders            *EncoderMap
 	BufUncompressedSize int
+
+	ackFuture      *PublicWriteAckFuture
+	ackFutureIndex int
 }
 
 func (m *messageWithDataContent) GetEncodedBytes(codec rawtopiccommon.Codec) ([]byte, error) {
@@ -223,3 +226,12 @@ func newMessageDataWithContent(
 		encoders:      encoders,
 	}
 }
+
+func messagesUncompressedSize(messages []messageWithDataContent) int {
+	size := 0
+	for i := range messages {
+		size += messages[i].BufUncompressedSize
+	}
+
+	return size
+}
@@ -41,6 +41,7 @@ type messageQueue struct {
 	lastWrittenIndex int
 	lastSentIndex    int
 	lastSeqNo        int64
+	bytesCount       int
 
 	messagesByOrder map[int]messageWithDataContent
 	seqNoToOrderID  map[int64]int
@@ -138,11 +139,12 @@ func (q *messageQueue) addMessageNeedLock(
 	q.messagesByOrder[messageIndex] = mess
 	q.seqNoToOrderID[mess.SeqNo] = messageIndex
 	q.lastSeqNo = mess.SeqNo
+	q.bytesCount += mess.BufUncompressedSize
 
 	return messageIndex
 }
 
-func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
+func (q *messageQueue) AcksReceived(partitionID int64, acks []rawtopicwriter.WriteAck) error {
 	ackReceivedCounter := 0
 	q.m.Lock()
 	defer func() {
@@ -157,7 +159,7 @@ func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
 	}
 
 	for i := range acks {
-		if err := q.ackReceivedNeedLock(acks[i].SeqNo); err != nil {
+		if err := q.ackReceivedNeedLock(partitionID, &acks[i]); err != nil {
 			return err
 		}
 		ackReceivedCounter++
@@ -168,13 +170,19 @@ func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
 	return nil
 }
 
-func (q *messageQueue) ackReceivedNeedLock(seqNo int64) error {
-	orderID, ok := q.seqNoToOrderID[seqNo]
+func (q *messageQueue) ackReceivedNeedLock(partitionID int64, ack *rawtopicwriter.WriteAck) error {
+	orderID, ok := q.seqNoToOrderID[ack.SeqNo]
 	if !ok {
 		return xerrors.WithStackTrace(errAckUnexpectedMessage)
 	}
 
-	delete(q.seqNoToOrderID, seqNo)
+	mess := q.messagesByOrder[orderID]
+	if mess.ackFuture != nil {
+		mess.ackFuture.ackReceivedNeedLock(mess.ackFutureIndex, newPublicWriteAck(partitionID, ack))
+	}
+	q.bytesCount -= mess.BufUncompressedSize
+
+	delete(q.seqNoToOrderID, ack.SeqNo)
 	delete(q.messagesByOrder, orderID)
 
 	return nil
@@ -201,9 +209,28 @@ func (q *messageQueue) Close(err error) error {
 	q.closedErr = err
 	close(q.closedChan)
 
+	for _, mess := range q.messagesByOrder {
+		if mess.ackFuture != nil {
+			mess.ackFuture.closeNeedLock(xerrors.WithStackTrace(fmt.Errorf("ydb: message queue closed with: %w", err)))
+		}
+	}
+
 	return nil
 }
 
+// Stats return count and uncompressed size of messages, which wait ack from server.
+// Closed queue has no messages for wait ack.
+func (q *messageQueue) Stats() (messagesCount, bytesCount int) {
+	q.m.RLock()
+	defer q.m.RUnlock()
+
+	if q.closed {
+		return 0, 0
+	}
+
+	return len(q.messagesByOrder), q.bytesCount
+}
+
 func (q *messageQueue) ensureNoSmallIntIndexes() {
 	for k := range q.messagesByOrder {
 		if k >= 0 && k < minPositiveIndexWhichOrderLessThenNegative {
@@ -319,6 +346,19 @@ func (q *messageQueue) Wait(ctx context.Context, waiter MessageQueueAckWaiter) e
 	}
 }
 
+// Flush wait acks for all messages, which was added to the queue before call
+func (q *messageQueue) Flush(ctx context.Context) error {
+	var waiter MessageQueueAckWaiter
+	q.m.WithRLock(func() {
+		waiter.sequenseNumbers = make([]int, 0, len(q.messagesByOrder))
+		for k := range q.messagesByOrder {
+			waiter.AddWaitIndex(k)
+		}
+	})
+
+	return q.Wait(ctx, waiter)
+}
+
 type MessageQueueAckWaiter struct {
 	sequenseNumbers []int
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 )
 
 func TestMessageQueue_AddMessages(t *testing.T) {
@@ -418,7 +419,7 @@ func TestRegressionIssue1038_ReceiveAckAfterCloseQueue(t *testing.T) {
 	counter++
 
 	require.NoError(t, q.Close(errors.New("test err")))
-	require.ErrorIs(t, q.AcksReceived([]rawtopicwriter.WriteAck{
+	require.ErrorIs(t, q.AcksReceived(0, []rawtopicwriter.WriteAck{
 		{
 			SeqNo:              1,
 			MessageWriteStatus: rawtopicwriter.MessageWriteStatus{},
@@ -432,7 +433,7 @@ func TestQueue_Ack(t *testing.T) {
 		q := newMessageQueue()
 		require.NoError(t, q.AddMessages(newTestMessagesWithContent(1, 2, 5)))
 
-		require.NoError(t, q.AcksReceived([]rawtopicwriter.WriteAck{
+		require.NoError(t, q.AcksReceived(0, []rawtopicwriter.WriteAck{
 			{
 				SeqNo: 2,
 			},
@@ -448,7 +449,7 @@ func TestQueue_Ack(t *testing.T) {
 		require.NoError(t, q.AddMessages(newTestMessagesWithContent(1)))
 
 		// remove first with the seqno
-		require.Error(t, q.AcksReceived([]rawtopicwriter.WriteAck{
+		require.Error(t, q.AcksReceived(0, []rawtopicwriter.WriteAck{
 			{
 				SeqNo: 5,
 			},
@@ -472,7 +473,7 @@ func TestQueue_Ack(t *testing.T) {
 		err := q.AddMessages(newTestMessagesWithContent(1, 2, 3))
 		require.NoError(t, err)
 
-		err = q.AcksReceived([]rawtopicwriter.WriteAck{
+		err = q.AcksReceived(0, []rawtopicwriter.WriteAck{
 			{
 				SeqNo: 1,
 			},
@@ -485,7 +486,7 @@ func TestQueue_Ack(t *testing.T) {
 		require.Equal(t, 2, receivedCount)
 
 		// Double ack
-		err = q.AcksReceived([]rawtopicwriter.WriteAck{
+		err = q.AcksReceived(0, []rawtopicwriter.WriteAck{
 			{
 				SeqNo: 1,
 			},
@@ -499,6 +500,94 @@ func TestQueue_Ack(t *testing.T) {
 	})
 }
 
+func TestQueue_AckFuture(t *testing.T) {
+	t.Run("AllAcks", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		q := newMessageQueue()
+		future := newPublicWriteAckFuture(2)
+		messages := newTestMessagesWithContent(1, 2)
+		for i := range messages {
+			messages[i].ackFuture = future
+			messages[i].ackFutureIndex = i
+		}
+		require.NoError(t, q.AddMessages(messages))
+
+		require.NoError(t, q.AcksReceived(3, []rawtopicwriter.WriteAck{
+			{
+				SeqNo: 2,
+				MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+					Type: rawtopicwriter.WriteStatusTypeSkipped,
+				},
+			},
+		}))
+		require.False(t, isClosed(future.Done()))
+
+		require.NoError(t, q.AcksReceived(3, []rawtopicwriter.WriteAck{
+			{
+				SeqNo: 1,
+				MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+					Type:          rawtopicwriter.WriteStatusTypeWritten,
+					WrittenOffset: 10,
+				},
+			},
+		}))
+
+		acks, err := future.Wait(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []PublicWriteAck{
+			{SeqNo: 1, PartitionID: 3, Offset: 10, Status: PublicWriteAckStatusWritten},
+			{SeqNo: 2, PartitionID: 3, Status: PublicWriteAckStatusSkipped},
+		}, acks)
+	})
+	t.Run("Closed", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		q := newMessageQueue()
+		future := newPublicWriteAckFuture(1)
+		messages := newTestMessagesWithContent(1)
+		messages[0].ackFuture = future
+		require.NoError(t, q.AddMessages(messages))
+
+		testErr := errors.New("test")
+		require.NoError(t, q.Close(testErr))
+
+		_, err := future.Wait(ctx)
+		require.ErrorIs(t, err, testErr)
+	})
+}
+
+func TestQueue_Flush(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		q := newMessageQueue()
+		require.NoError(t, q.Flush(xtest.Context(t)))
+	})
+	t.Run("WaitAllAcks", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		q := newMessageQueue()
+		require.NoError(t, q.AddMessages(newTestMessagesWithContent(1, 2)))
+
+		flushed := make(empty.Chan)
+		go func() {
+			defer close(flushed)
+			require.NoError(t, q.Flush(ctx))
+		}()
+
+		require.NoError(t, q.AcksReceived(0, []rawtopicwriter.WriteAck{{SeqNo: 1}}))
+		require.False(t, isClosed(flushed))
+
+		require.NoError(t, q.AcksReceived(0, []rawtopicwriter.WriteAck{{SeqNo: 2}}))
+		xtest.WaitChannelClosed(t, flushed)
+	})
+	t.Run("Closed", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		q := newMessageQueue()
+		require.NoError(t, q.AddMessages(newTestMessagesWithContent(1)))
+
+		testErr := errors.New("test")
+		require.NoError(t, q.Close(testErr))
+		require.ErrorIs(t, q.Flush(ctx), testErr)
+	})
+}
+
 func waitGetMessageStarted(q *messageQueue) {
 	q.notifyNewMessages()
 	for len(q.hasNewMessages) != 0 {
@@ -514,3 +603,30 @@ func getSeqNumbers(messages []messageWithDataContent) []int64 {
 
 	return res
 }
+
+func TestQueue_Stats(t *testing.T) {
+	q := newMessageQueue()
+	messages := newTestMessagesWithContent(1, 2)
+	messages[0].BufUncompressedSize = 10
+	messages[1].BufUncompressedSize = 20
+	require.NoError(t, q.AddMessages(messages))
+
+	messagesCount, bytesCount := q.Stats()
+	require.Equal(t, 2, messagesCount)
+	require.Equal(t, 30, bytesCount)
+
+	require.NoError(t, q.AcksReceived(0, []rawtopicwriter.WriteAck{{SeqNo: 1}}))
+	messagesCount, bytesCount = q.Stats()
+	require.Equal(t, 1, messagesCount)
+	require.Equal(t, 20, bytesCount)
+}
+
+func TestQueue_StatsAfterClose(t *testing.T) {
+	q := newMessageQueue()
+	require.NoError(t, q.AddMessages(newTestMessagesWithContent(1, 2)))
+	require.NoError(t, q.Close(errors.New("test")))
+
+	messagesCount, bytesCount := q.Stats()
+	require.Zero(t, messagesCount)
+	require.Zero(t, bytesCount)
+}
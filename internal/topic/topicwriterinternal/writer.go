@@ -20,6 +20,7 @@ type RawTopicWriterStream interface {
 type Writer struct {
 	streamWriter StreamWriter
 	clock        clockwork.Clock
+	onClose      func()
 }
 
 func NewWriter(cred credentials.Credentials, options []PublicWriterOption) (*Writer, error) {
@@ -37,6 +38,7 @@ func NewWriter(cred credentials.Credentials, options []PublicWriterOption) (*Wri
 	return &Writer{
 		streamWriter: writerImpl,
 		clock:        clockwork.NewRealClock(),
+		onClose:      cfg.onClose,
 	}, nil
 }
 
@@ -48,10 +50,30 @@ func (w *Writer) Write(ctx context.Context, messages ...PublicMessage) error {
 	return w.streamWriter.Write(ctx, messages)
 }
 
+func (w *Writer) WriteWithAck(ctx context.Context, messages ...PublicMessage) (*PublicWriteAckFuture, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return w.streamWriter.WriteWithAck(ctx, messages)
+}
+
+func (w *Writer) Flush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return w.streamWriter.Flush(ctx)
+}
+
 func (w *Writer) WaitInit(ctx context.Context) (info InitialInfo, err error) {
 	return w.streamWriter.WaitInit(ctx)
 }
 
 func (w *Writer) Close(ctx context.Context) error {
+	if w.onClose != nil {
+		defer w.onClose()
+	}
+
 	return w.streamWriter.Close(ctx)
 }
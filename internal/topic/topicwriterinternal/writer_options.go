@@ -149,6 +149,14 @@ func WithTrace(tracer *trace.Topic) PublicWriterOption {
 	}
 }
 
+// WithOnClose for internal usage only
+// no proxy to public interface.
+func WithOnClose(onClose func()) PublicWriterOption {
+	return func(cfg *WriterReconnectorConfig) {
+		cfg.onClose = onClose
+	}
+}
+
 func WithTopic(topic string) PublicWriterOption {
 	return func(cfg *WriterReconnectorConfig) {
 		cfg.topic = topic
@@ -62,6 +62,7 @@ type WriterReconnectorConfig struct {
 	RetrySettings                topic.RetrySettings
 
 	connectTimeout time.Duration
+	onClose        func()
 }
 
 func (cfg *WriterReconnectorConfig) validate() error {
@@ -207,19 +208,59 @@ func (w *WriterReconnector) start() {
 }
 
 func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage) error {
+	waiter, err := w.write(ctx, messages, nil)
+	if err != nil {
+		return err
+	}
+
+	if !w.cfg.WaitServerAck {
+		return nil
+	}
+
+	return w.queue.Wait(ctx, waiter)
+}
+
+// WriteWithAck put messages to the queue and return future for receive acks from server.
+// It doesn't wait acks even if WaitServerAck enabled.
+func (w *WriterReconnector) WriteWithAck(ctx context.Context, messages []PublicMessage) (
+	*PublicWriteAckFuture,
+	error,
+) {
+	future := newPublicWriteAckFuture(len(messages))
+	if _, err := w.write(ctx, messages, future); err != nil {
+		return nil, err
+	}
+
+	return future, nil
+}
+
+// Flush wait acks for all messages, written before call
+func (w *WriterReconnector) Flush(ctx context.Context) error {
 	if err := w.background.CloseReason(); err != nil {
 		return xerrors.WithStackTrace(fmt.Errorf("ydb: writer is closed: %w", err))
 	}
+
+	return w.queue.Flush(ctx)
+}
+
+func (w *WriterReconnector) write(
+	ctx context.Context,
+	messages []PublicMessage,
+	future *PublicWriteAckFuture,
+) (waiter MessageQueueAckWaiter, err error) {
+	if err := w.background.CloseReason(); err != nil {
+		return waiter, xerrors.WithStackTrace(fmt.Errorf("ydb: writer is closed: %w", err))
+	}
 	if ctx.Err() != nil {
-		return ctx.Err()
+		return waiter, ctx.Err()
 	}
 	if len(messages) == 0 {
-		return nil
+		return waiter, nil
 	}
 
 	semaphoreWeight := int64(len(messages))
 	if semaphoreWeight > int64(w.cfg.MaxQueueLen) {
-		return xerrors.WithStackTrace(fmt.Errorf(
+		return waiter, xerrors.WithStackTrace(fmt.Errorf(
 			"ydb: add more messages, then max queue limit. max queue: %v, try to add: %v: %w",
 			w.cfg.MaxQueueLen,
 			semaphoreWeight,
@@ -227,7 +268,7 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 		))
 	}
 	if err := w.semaphore.Acquire(ctx, semaphoreWeight); err != nil {
-		return xerrors.WithStackTrace(
+		return waiter, xerrors.WithStackTrace(
 			fmt.Errorf("ydb: add new messages exceed max queue size limit. Add count: %v, max size: %v: %w",
 				semaphoreWeight,
 				w.cfg.MaxQueueLen,
@@ -240,18 +281,24 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 
 	messagesSlice, err := w.createMessagesWithContent(messages)
 	if err != nil {
-		return err
+		return waiter, err
 	}
 
 	if err = w.checkMessages(messagesSlice); err != nil {
-		return err
+		return waiter, err
+	}
+
+	if future != nil {
+		for i := range messagesSlice {
+			messagesSlice[i].ackFuture = future
+			messagesSlice[i].ackFutureIndex = i
+		}
 	}
 
 	if err = w.waitFirstInitResponse(ctx); err != nil {
-		return err
+		return waiter, err
 	}
 
-	var waiter MessageQueueAckWaiter
 	w.m.WithLock(func() {
 		// need set numbers and add to queue atomically
 		err = w.fillFields(messagesSlice)
@@ -259,7 +306,7 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 			return
 		}
 
-		if w.cfg.WaitServerAck {
+		if w.cfg.WaitServerAck && future == nil {
 			waiter, err = w.queue.AddMessagesWithWaiter(messagesSlice)
 		} else {
 			err = w.queue.AddMessages(messagesSlice)
@@ -269,15 +316,20 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 			semaphoreWeight = 0
 		}
 	})
-	if err != nil {
-		return err
+	if err == nil {
+		w.traceQueueChanged()
 	}
 
-	if !w.cfg.WaitServerAck {
-		return nil
+	return waiter, err
+}
+
+func (w *WriterReconnector) traceQueueChanged() {
+	if w.cfg.tracer.OnWriterQueueChanged == nil {
+		return
 	}
 
-	return w.queue.Wait(ctx, waiter)
+	messagesCount, bytesCount := w.queue.Stats()
+	trace.TopicOnWriterQueueChanged(w.cfg.tracer, w.writerInstanceID, w.cfg.topic, messagesCount, bytesCount)
 }
 
 func (w *WriterReconnector) checkMessages(messages []messageWithDataContent) error {
@@ -310,6 +362,7 @@ func (w *WriterReconnector) createMessagesWithContent(messages []PublicMessage)
 		messages[0].SeqNo,
 		len(messages),
 		trace.TopicWriterCompressMessagesReasonCompressDataOnWriteReadData,
+		w.cfg.topic,
 	)
 
 	targetCodec := w.cfg.forceCodec
@@ -341,6 +394,9 @@ func (w *WriterReconnector) close(ctx context.Context, reason error) (resErr err
 		resErr = bgErr
 	}
 
+	// final empty state of closed queue before close event done
+	w.traceQueueChanged()
+
 	return resErr
 }
 
@@ -480,6 +536,7 @@ func (w *WriterReconnector) connectWithTimeout(streamLifetimeContext context.Con
 
 func (w *WriterReconnector) onAckReceived(count int) {
 	w.semaphore.Release(int64(count))
+	w.traceQueueChanged()
 }
 
 func (w *WriterReconnector) onWriterChange(writerStream *SingleStreamWriter) {
@@ -23,6 +23,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 var testCommonEncoders = NewEncoderMap()
@@ -178,6 +179,66 @@ func TestWriterImpl_Write(t *testing.T) {
 	})
 }
 
+func TestWriterImpl_WriteWithAck(t *testing.T) {
+	xtest.TestManyTimes(t, func(t testing.TB) {
+		e := newTestEnv(t, &testEnvOptions{
+			writerOptions: []PublicWriterOption{
+				WithWaitAckOnWrite(true),
+			},
+		})
+
+		messageTime := time.Date(2022, 9, 7, 11, 34, 0, 0, time.UTC)
+		messageData := []byte("123")
+
+		messagesSent := make(empty.Chan)
+		e.stream.EXPECT().Send(gomock.Any()).Do(func(_ interface{}) {
+			close(messagesSent)
+		}).Return(nil)
+
+		future, err := e.writer.WriteWithAck(e.ctx, []PublicMessage{
+			{SeqNo: 1, CreatedAt: messageTime, Data: bytes.NewReader(messageData)},
+			{SeqNo: 2, CreatedAt: messageTime, Data: bytes.NewReader(messageData)},
+		})
+		require.NoError(t, err)
+
+		flushed := make(empty.Chan)
+		go func() {
+			defer close(flushed)
+			require.NoError(t, e.writer.Flush(e.ctx))
+		}()
+
+		<-messagesSent
+		e.sendFromServer(&rawtopicwriter.WriteResult{
+			Acks: []rawtopicwriter.WriteAck{
+				{
+					SeqNo: 1,
+					MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+						Type:          rawtopicwriter.WriteStatusTypeWritten,
+						WrittenOffset: 4,
+					},
+				},
+				{
+					SeqNo: 2,
+					MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+						Type:          rawtopicwriter.WriteStatusTypeSkipped,
+						SkippedReason: rawtopicwriter.WriteStatusSkipReasonAlreadyWritten,
+					},
+				},
+			},
+			PartitionID: e.partitionID,
+		})
+
+		acks, err := future.Wait(e.ctx)
+		require.NoError(t, err)
+		require.Equal(t, []PublicWriteAck{
+			{SeqNo: 1, PartitionID: e.partitionID, Offset: 4, Status: PublicWriteAckStatusWritten},
+			{SeqNo: 2, PartitionID: e.partitionID, Status: PublicWriteAckStatusSkipped},
+		}, acks)
+
+		xtest.WaitChannelClosed(t, flushed)
+	})
+}
+
 func TestWriterImpl_WriteCodecs(t *testing.T) {
 	t.Run("ForceRaw", func(t *testing.T) {
 		var err error
@@ -302,7 +363,7 @@ func TestWriterReconnector_Write_QueueLimit(t *testing.T) {
 
 		go func() {
 			waitStartQueueWait(1)
-			ackErr := w.queue.AcksReceived([]rawtopicwriter.WriteAck{
+			ackErr := w.queue.AcksReceived(0, []rawtopicwriter.WriteAck{
 				{
 					SeqNo: 1,
 				},
@@ -315,6 +376,47 @@ func TestWriterReconnector_Write_QueueLimit(t *testing.T) {
 	})
 }
 
+func TestWriterReconnector_CloseWithPendingMessages(t *testing.T) {
+	ctx := xtest.Context(t)
+
+	var (
+		m            sync.Mutex
+		closed       bool
+		closedQueues []int
+	)
+	w := newTestWriterStopped(WithTrace(&trace.Topic{
+		OnWriterQueueChanged: func(info trace.TopicWriterQueueChangedInfo) {
+			m.Lock()
+			defer m.Unlock()
+
+			if closed {
+				closedQueues = append(closedQueues, info.MessagesCount)
+			}
+		},
+		OnWriterClose: func(info trace.TopicWriterCloseStartInfo) func(trace.TopicWriterCloseDoneInfo) {
+			m.Lock()
+			defer m.Unlock()
+
+			closed = true
+
+			return nil
+		},
+	}))
+	w.firstConnectionHandled.Store(true)
+
+	require.NoError(t, w.Write(ctx, newTestMessages(1, 2)))
+	require.NoError(t, w.Close(ctx))
+
+	m.Lock()
+	defer m.Unlock()
+
+	// release of pending messages on close reports empty queue
+	require.NotEmpty(t, closedQueues)
+	for _, count := range closedQueues {
+		require.Zero(t, count)
+	}
+}
+
 func TestEnv(t *testing.T) {
 	xtest.TestManyTimes(t, func(t testing.TB) {
 		env := newTestEnv(t, nil)
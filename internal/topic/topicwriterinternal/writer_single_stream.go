@@ -151,6 +151,7 @@ func (w *SingleStreamWriter) initStream() (err error) {
 		w.cfg.tracer,
 		w.cfg.reconnectorInstanceID,
 		w.SessionID,
+		w.cfg.topic,
 	)
 
 	w.SessionID = result.SessionID
@@ -188,7 +189,7 @@ func (w *SingleStreamWriter) receiveMessagesLoop(ctx context.Context) {
 
 		switch m := mess.(type) {
 		case *rawtopicwriter.WriteResult:
-			if err = w.cfg.queue.AcksReceived(m.Acks); err != nil && !errors.Is(err, errCloseClosedMessageQueue) {
+			if err = w.cfg.queue.AcksReceived(m.PartitionID, m.Acks); err != nil && !errors.Is(err, errCloseClosedMessageQueue) {
 				reason := xerrors.WithStackTrace(err)
 				closeCtx, closeCtxCancel := xcontext.WithCancel(ctx)
 				closeCtxCancel()
@@ -235,6 +236,8 @@ func (w *SingleStreamWriter) sendMessagesFromQueueToStreamLoop(ctx context.Conte
 			targetCodec.ToInt32(),
 			messages[0].SeqNo,
 			len(messages),
+			w.cfg.topic,
+			messagesUncompressedSize(messages),
 		)
 		err = sendMessagesToStream(w.cfg.stream, targetCodec, messages)
 		onSentComplete(err)
@@ -7,6 +7,8 @@ import (
 //go:generate mockgen -source writer_stream_interface.go -destination writer_stream_interface_mock_test.go -package topicwriterinternal -write_package_comment=false
 type StreamWriter interface {
 	Write(ctx context.Context, messages []PublicMessage) error
+	WriteWithAck(ctx context.Context, messages []PublicMessage) (*PublicWriteAckFuture, error)
+	Flush(ctx context.Context) error
 	WaitInit(ctx context.Context) (info InitialInfo, err error)
 	Close(ctx context.Context) error
 }
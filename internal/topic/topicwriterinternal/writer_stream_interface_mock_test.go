@@ -50,6 +50,20 @@ func (mr *MockStreamWriterMockRecorder) Close(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStreamWriter)(nil).Close), ctx)
 }
 
+// Flush mocks base method.
+func (m *MockStreamWriter) Flush(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Flush", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockStreamWriterMockRecorder) Flush(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockStreamWriter)(nil).Flush), ctx)
+}
+
 // WaitInit mocks base method.
 func (m *MockStreamWriter) WaitInit(ctx context.Context) (InitialInfo, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +92,18 @@ func (mr *MockStreamWriterMockRecorder) Write(ctx, messages any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockStreamWriter)(nil).Write), ctx, messages)
 }
+
+// WriteWithAck mocks base method.
+func (m *MockStreamWriter) WriteWithAck(ctx context.Context, messages []PublicMessage) (*PublicWriteAckFuture, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteWithAck", ctx, messages)
+	ret0, _ := ret[0].(*PublicWriteAckFuture)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteWithAck indicates an expected call of WriteWithAck.
+func (mr *MockStreamWriterMockRecorder) WriteWithAck(ctx, messages any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteWithAck", reflect.TypeOf((*MockStreamWriter)(nil).WriteWithAck), ctx, messages)
+}
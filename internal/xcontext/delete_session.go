@@ -0,0 +1,19 @@
+package xcontext
+
+import "context"
+
+type ctxDeleteSessionKey struct{}
+
+// WithDeleteSession defines decision about deletion of session by error of operation
+func WithDeleteSession(ctx context.Context, deleteSession func(err error) bool) context.Context {
+	return context.WithValue(ctx, ctxDeleteSessionKey{}, deleteSession)
+}
+
+// MustDeleteSession returns decision about deletion of session from context or default decision
+func MustDeleteSession(ctx context.Context, err error, defaultDecision func(err error) bool) bool {
+	if deleteSession, ok := ctx.Value(ctxDeleteSessionKey{}).(func(err error) bool); ok {
+		return deleteSession(err)
+	}
+
+	return defaultDecision(err)
+}
@@ -0,0 +1,16 @@
+package xcontext
+
+import "context"
+
+type ctxReadOnlyKey struct{}
+
+// WithReadOnly marks context of operation with read-only transaction control
+func WithReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxReadOnlyKey{}, true)
+}
+
+func IsReadOnly(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(ctxReadOnlyKey{}).(bool)
+
+	return readOnly
+}
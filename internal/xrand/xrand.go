@@ -9,6 +9,7 @@ import (
 type Rand interface {
 	Int64(max int64) int64
 	Int(max int) int
+	Float64() float64
 	Shuffle(n int, swap func(i, j int))
 }
 
@@ -61,6 +62,15 @@ func (r *r) Int(max int) int {
 	return int(r.int64n(int64(max)))
 }
 
+func (r *r) Float64() float64 {
+	if r.m != nil {
+		r.m.Lock()
+		defer r.m.Unlock()
+	}
+
+	return r.r.Float64()
+}
+
 func (r *r) Shuffle(n int, swap func(i, j int)) {
 	if r.m != nil {
 		r.m.Lock()
@@ -1,10 +1,190 @@
 package log
 
 import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 // Coordination makes trace.Coordination with logging events from details.
 func Coordination(l Logger, d trace.Detailer, opts ...Option) (t trace.Coordination) {
+	return internalCoordination(wrapLogger(l, opts...), d)
+}
+
+//nolint:funlen
+func internalCoordination(
+	l *wrapper, //nolint:interfacer
+	d trace.Detailer,
+) (t trace.Coordination) {
+	t.OnSession = func(info trace.CoordinationSessionStartInfo) func(trace.CoordinationSessionDoneInfo) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "coordination", "session")
+		path := info.Path
+		l.Log(ctx, "start",
+			String("path", path),
+		)
+		start := time.Now()
+
+		return func(info trace.CoordinationSessionDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+					String("path", path),
+					sessionIDField(info.SessionID),
+				)
+			} else {
+				l.Log(WithLevel(ctx, ERROR), "failed",
+					latencyField(start),
+					String("path", path),
+					Error(info.Error),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnSessionStart = func(info trace.CoordinationSessionStartStartInfo) func(trace.CoordinationSessionStartDoneInfo) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return nil
+		}
+		ctx := with(context.Background(), TRACE, "ydb", "coordination", "session", "start")
+		path := info.Path
+		sessionID := info.SessionID
+		l.Log(ctx, "start",
+			String("path", path),
+			sessionIDField(sessionID),
+		)
+		start := time.Now()
+
+		return func(info trace.CoordinationSessionStartDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+					String("path", path),
+					sessionIDField(info.SessionID),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "failed",
+					latencyField(start),
+					String("path", path),
+					sessionIDField(sessionID),
+					Error(info.Error),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnSessionClose = func(info trace.CoordinationSessionCloseStartInfo) func(trace.CoordinationSessionCloseDoneInfo) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "coordination", "session", "close")
+		sessionID := info.SessionID
+		l.Log(ctx, "start",
+			sessionIDField(sessionID),
+		)
+		start := time.Now()
+
+		return func(info trace.CoordinationSessionCloseDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+					sessionIDField(sessionID),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "failed",
+					latencyField(start),
+					sessionIDField(sessionID),
+					Error(info.Error),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnSessionKeepAliveTimeout = func(info trace.CoordinationSessionKeepAliveTimeoutInfo) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), WARN, "ydb", "coordination", "session", "keep", "alive", "timeout")
+		l.Log(ctx, "",
+			sessionIDField(info.SessionID),
+			Duration("timeout", info.Timeout),
+			Stringer("last_response_time", info.LastResponseTime),
+		)
+	}
+	t.OnSessionServerError = func(info trace.CoordinationSessionServerErrorInfo) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), WARN, "ydb", "coordination", "session", "server", "error")
+		l.Log(ctx, "",
+			sessionIDField(info.SessionID),
+			Error(info.Error),
+			versionField(),
+		)
+	}
+	t.OnSessionLost = func(info trace.CoordinationSessionLostInfo) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), ERROR, "ydb", "coordination", "session", "lost")
+		l.Log(ctx, "",
+			sessionIDField(info.SessionID),
+			Error(info.Error),
+			versionField(),
+		)
+	}
+	t.OnSessionRequest = func(
+		info trace.CoordinationSessionRequestStartInfo,
+	) func(
+		trace.CoordinationSessionRequestDoneInfo,
+	) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "coordination", "session", "request")
+		sessionID := info.SessionID
+		method := info.Method
+		semaphore := info.Semaphore
+		l.Log(ctx, "start",
+			sessionIDField(sessionID),
+			String("method", method),
+			String("semaphore", semaphore),
+		)
+		start := time.Now()
+
+		return func(info trace.CoordinationSessionRequestDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+					sessionIDField(sessionID),
+					String("method", method),
+					String("semaphore", semaphore),
+				)
+			} else {
+				lvl := WARN
+				if !xerrors.IsYdb(info.Error) {
+					lvl = DEBUG
+				}
+				l.Log(WithLevel(ctx, lvl), "failed",
+					latencyField(start),
+					sessionIDField(sessionID),
+					String("method", method),
+					String("semaphore", semaphore),
+					Error(info.Error),
+					versionField(),
+				)
+			}
+		}
+	}
+
 	return t
 }
+
+func sessionIDField(sessionID uint64) Field {
+	return String("id", strconv.FormatUint(sessionID, 10))
+}
@@ -103,6 +103,61 @@ func internalDriver(l Logger, d trace.Detailer) (t trace.Driver) { //nolint:gocy
 			}
 		}
 	}
+	t.OnDrain = func(info trace.DriverDrainStartInfo) func(trace.DriverDrainDoneInfo) {
+		if d.Details()&trace.DriverEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, INFO, "ydb", "driver", "drain")
+		l.Log(ctx, "start",
+			Duration("timeout", info.Timeout),
+			Int("inflight", info.Inflight),
+		)
+		start := time.Now()
+
+		return func(info trace.DriverDrainDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "failed",
+					Error(info.Error),
+					Int("inflight", info.Inflight),
+					latencyField(start),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnDrainClient = func(info trace.DriverDrainClientStartInfo) func(trace.DriverDrainClientDoneInfo) {
+		if d.Details()&trace.DriverEvents == 0 {
+			return nil
+		}
+		client := info.Client
+		ctx := with(*info.Context, DEBUG, "ydb", "driver", "drain", "client")
+		l.Log(ctx, "start",
+			String("client", client),
+			Int("inflight", info.Inflight),
+		)
+		start := time.Now()
+
+		return func(info trace.DriverDrainClientDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					String("client", client),
+					latencyField(start),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "failed",
+					Error(info.Error),
+					String("client", client),
+					Int("inflight", info.Inflight),
+					latencyField(start),
+					versionField(),
+				)
+			}
+		}
+	}
 	t.OnConnDial = func(info trace.DriverConnDialStartInfo) func(trace.DriverConnDialDoneInfo) {
 		if d.Details()&trace.DriverConnEvents == 0 {
 			return nil
@@ -453,6 +508,35 @@ func internalDriver(l Logger, d trace.Detailer) (t trace.Driver) { //nolint:gocy
 			)
 		}
 	}
+	t.OnBalancerEndpointScore = func(info trace.DriverBalancerEndpointScoreInfo) {
+		if d.Details()&trace.DriverBalancerEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), TRACE, "ydb", "driver", "balancer", "endpoint", "score")
+		l.Log(ctx, "",
+			Stringer("endpoint", info.Endpoint),
+			Duration("latency", info.Latency),
+			Int64("inflight", info.Inflight),
+			Any("score", info.Score),
+		)
+	}
+	t.OnBalancerOutlierStateChange = func(info trace.DriverBalancerOutlierStateChangeInfo) {
+		if d.Details()&trace.DriverBalancerEvents == 0 {
+			return
+		}
+		level := INFO
+		if info.State == "ejected" {
+			level = WARN
+		}
+		ctx := with(context.Background(), level, "ydb", "driver", "balancer", "outlier", "state", "change")
+		l.Log(ctx, "",
+			Stringer("endpoint", info.Endpoint),
+			String("previousState", info.PreviousState),
+			String("state", info.State),
+			String("reason", info.Reason),
+			Duration("ejectionTime", info.EjectionTime),
+		)
+	}
 	t.OnGetCredentials = func(info trace.DriverGetCredentialsStartInfo) func(trace.DriverGetCredentialsDoneInfo) {
 		if d.Details()&trace.DriverCredentialsEvents == 0 {
 			return nil
@@ -0,0 +1,62 @@
+package log
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// MultiCluster makes trace.MultiCluster with logging events from details
+func MultiCluster(l Logger, d trace.Detailer, opts ...Option) (t trace.MultiCluster) {
+	return internalMultiCluster(wrapLogger(l, opts...), d)
+}
+
+func internalMultiCluster(l Logger, d trace.Detailer) (t trace.MultiCluster) {
+	t.OnCall = func(info trace.MultiClusterCallStartInfo) func(trace.MultiClusterCallDoneInfo) {
+		if d.Details()&trace.MultiClusterEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "multicluster", "call")
+		l.Log(ctx, "start",
+			String("method", info.Method),
+			String("cluster", info.Cluster),
+			Bool("read_only", info.ReadOnly),
+		)
+		start := time.Now()
+
+		return func(info trace.MultiClusterCallDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "failed",
+					Error(info.Error),
+					latencyField(start),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnHealthChange = func(info trace.MultiClusterHealthChangeInfo) {
+		if d.Details()&trace.MultiClusterEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), INFO, "ydb", "multicluster", "health")
+		if info.Healthy {
+			l.Log(ctx, "cluster is healthy",
+				String("cluster", info.Cluster),
+				Duration("latency", info.Latency),
+			)
+		} else {
+			l.Log(WithLevel(ctx, WARN), "cluster is unhealthy",
+				String("cluster", info.Cluster),
+				Error(info.Error),
+				versionField(),
+			)
+		}
+	}
+
+	return t
+}
@@ -1,6 +1,7 @@
 package log
 
 import (
+	"context"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -27,7 +28,9 @@ func internalQuery(
 			return nil
 		}
 		ctx := with(*info.Context, TRACE, "ydb", "query", "do")
-		l.Log(ctx, "start")
+		l.Log(ctx, "start",
+			String("label", info.Label),
+		)
 		start := time.Now()
 
 		return func(info trace.QueryDoIntermediateInfo) func(trace.QueryDoDoneInfo) {
@@ -75,11 +78,13 @@ func internalQuery(
 	) func(
 		trace.QueryDoTxDoneInfo,
 	) {
-		if d.Details()&trace.TablePoolAPIEvents == 0 {
+		if d.Details()&trace.QueryPoolEvents == 0 {
 			return nil
 		}
 		ctx := with(*info.Context, TRACE, "ydb", "query", "do", "tx")
-		l.Log(ctx, "start")
+		l.Log(ctx, "start",
+			String("label", info.Label),
+		)
 		start := time.Now()
 
 		return func(info trace.QueryDoTxIntermediateInfo) func(trace.QueryDoTxDoneInfo) {
@@ -121,5 +126,118 @@ func internalQuery(
 		}
 	}
 
+	t.OnSessionCreate = func(info trace.QuerySessionCreateStartInfo) func(trace.QuerySessionCreateDoneInfo) {
+		if d.Details()&trace.QuerySessionEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "query", "session", "create")
+		l.Log(ctx, "start")
+		start := time.Now()
+
+		return func(info trace.QuerySessionCreateDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+					String("id", info.SessionID),
+					Int64("node_id", info.NodeID),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "failed",
+					latencyField(start),
+					Error(info.Error),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnSessionDelete = func(info trace.QuerySessionDeleteStartInfo) func(trace.QuerySessionDeleteDoneInfo) {
+		if d.Details()&trace.QuerySessionEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "query", "session", "delete")
+		sessionID := info.SessionID
+		l.Log(ctx, "start",
+			String("id", sessionID),
+			Int64("node_id", info.NodeID),
+		)
+		start := time.Now()
+
+		return func(info trace.QuerySessionDeleteDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+					String("id", sessionID),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "failed",
+					latencyField(start),
+					String("id", sessionID),
+					Error(info.Error),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnSessionClose = func(info trace.QuerySessionCloseInfo) {
+		if d.Details()&trace.QueryPoolEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), TRACE, "ydb", "query", "session", "close")
+		l.Log(ctx, "",
+			String("id", info.SessionID),
+			Int64("node_id", info.NodeID),
+		)
+	}
+	t.OnSessionExecute = func(info trace.QuerySessionExecuteStartInfo) func(trace.QuerySessionExecuteDoneInfo) {
+		if d.Details()&trace.QueryExecuteEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "query", "session", "execute")
+		sessionID := info.SessionID
+		query := info.Query
+		l.Log(ctx, "start",
+			appendFieldByCondition(l.logQuery,
+				String("query", query),
+				String("id", sessionID),
+				String("label", info.Label),
+			)...,
+		)
+		start := time.Now()
+
+		return func(info trace.QuerySessionExecuteDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+					String("id", sessionID),
+				)
+			} else {
+				lvl := ERROR
+				if !xerrors.IsYdb(info.Error) {
+					lvl = DEBUG
+				}
+				l.Log(WithLevel(ctx, lvl), "failed",
+					appendFieldByCondition(l.logQuery,
+						String("query", query),
+						latencyField(start),
+						String("id", sessionID),
+						Error(info.Error),
+						versionField(),
+					)...,
+				)
+			}
+		}
+	}
+	t.OnResultNextPart = func(info trace.QueryResultNextPartInfo) {
+		if d.Details()&trace.QueryExecuteEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), TRACE, "ydb", "query", "result", "next", "part")
+		l.Log(ctx, "",
+			String("id", info.SessionID),
+			String("label", info.Label),
+			Int("bytes", info.BytesCount),
+		)
+	}
+
 	return t
 }
@@ -1,10 +1,68 @@
 package log
 
 import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 // Ratelimiter returns trace.Ratelimiter with logging events from details.
 func Ratelimiter(l Logger, d trace.Detailer, opts ...Option) (t trace.Ratelimiter) {
+	return internalRatelimiter(wrapLogger(l, opts...), d)
+}
+
+func internalRatelimiter(
+	l *wrapper, //nolint:interfacer
+	d trace.Detailer,
+) (t trace.Ratelimiter) {
+	t.OnAcquireResource = func(
+		info trace.RatelimiterAcquireResourceStartInfo,
+	) func(
+		trace.RatelimiterAcquireResourceDoneInfo,
+	) {
+		if d.Details()&trace.RatelimiterEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "ratelimiter", "acquire", "resource")
+		coordinationNodePath := info.CoordinationNodePath
+		resourcePath := info.ResourcePath
+		amount := info.Amount
+		report := info.Report
+		l.Log(ctx, "start",
+			String("coordination_node", coordinationNodePath),
+			String("resource", resourcePath),
+			Int("amount", int(amount)),
+			Bool("report", report),
+		)
+		start := time.Now()
+
+		return func(info trace.RatelimiterAcquireResourceDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+					String("coordination_node", coordinationNodePath),
+					String("resource", resourcePath),
+					Int("amount", int(amount)),
+					Bool("report", report),
+				)
+			} else {
+				lvl := WARN
+				if !xerrors.IsYdb(info.Error) {
+					lvl = DEBUG
+				}
+				l.Log(WithLevel(ctx, lvl), "failed",
+					latencyField(start),
+					String("coordination_node", coordinationNodePath),
+					String("resource", resourcePath),
+					Int("amount", int(amount)),
+					Bool("report", report),
+					Error(info.Error),
+					versionField(),
+				)
+			}
+		}
+	}
+
 	return t
 }
@@ -84,5 +84,63 @@ func internalRetry(l Logger, d trace.Detailer) (t trace.Retry) {
 		}
 	}
 
+	t.OnHedge = func(info trace.RetryHedgeStartInfo) func(trace.RetryHedgeDoneInfo) {
+		if d.Details()&trace.RetryEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, DEBUG, "ydb", "retry", "hedge")
+		label := info.Label
+		if !info.Allowed {
+			l.Log(ctx, "hedging budget exhausted",
+				String("label", label),
+				Duration("delay", info.Delay),
+			)
+
+			return nil
+		}
+		l.Log(ctx, "start",
+			String("label", label),
+			Duration("delay", info.Delay),
+		)
+		start := time.Now()
+
+		return func(info trace.RetryHedgeDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					String("label", label),
+					latencyField(start),
+					Bool("hedge_won", info.HedgeWon),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "failed",
+					Error(info.Error),
+					String("label", label),
+					latencyField(start),
+					versionField(),
+				)
+			}
+		}
+	}
+
+	t.OnBudget = func(info trace.RetryBudgetInfo) {
+		if d.Details()&trace.RetryEvents == 0 {
+			return
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "retry", "budget")
+		if info.Allowed {
+			l.Log(ctx, "retry allowed",
+				String("label", info.Label),
+				Any("tokens", info.Tokens),
+				Any("capacity", info.Capacity),
+			)
+		} else {
+			l.Log(WithLevel(ctx, WARN), "retry budget exhausted",
+				String("label", info.Label),
+				Any("tokens", info.Tokens),
+				Any("capacity", info.Capacity),
+			)
+		}
+	}
+
 	return t
 }
@@ -18,6 +18,11 @@ func driver(config Config) (t trace.Driver) {
 	banned := config.WithSystem("conn").GaugeVec("banned", "endpoint", "node_id", "cause")
 	requests := config.WithSystem("conn").CounterVec("requests", "status", "method", "endpoint", "node_id")
 	tli := config.CounterVec("transaction_locks_invalidated")
+	outlierTransitions := config.WithSystem("balancer").CounterVec("outlier_transitions",
+		"endpoint", "node_id", "state", "reason",
+	)
+	ejected := config.WithSystem("balancer").GaugeVec("ejected", "endpoint", "node_id")
+	drainInflight := config.WithSystem("drain").GaugeVec("inflight", "client")
 
 	type endpointKey struct {
 		localDC bool
@@ -25,6 +30,21 @@ func driver(config Config) (t trace.Driver) {
 	}
 	knownEndpoints := make(map[endpointKey]struct{})
 
+	t.OnDrainClient = func(info trace.DriverDrainClientStartInfo) func(trace.DriverDrainClientDoneInfo) {
+		if config.Details()&trace.DriverEvents == 0 {
+			return nil
+		}
+		client := info.Client
+		drainInflight.With(map[string]string{
+			"client": client,
+		}).Set(float64(info.Inflight))
+
+		return func(info trace.DriverDrainClientDoneInfo) {
+			drainInflight.With(map[string]string{
+				"client": client,
+			}).Set(float64(info.Inflight))
+		}
+	}
 	t.OnConnInvoke = func(info trace.DriverConnInvokeStartInfo) func(trace.DriverConnInvokeDoneInfo) {
 		var (
 			method   = info.Method
@@ -93,6 +113,24 @@ func driver(config Config) (t trace.Driver) {
 			}).Inc()
 		}
 	}
+	t.OnBalancerOutlierStateChange = func(info trace.DriverBalancerOutlierStateChangeInfo) {
+		if config.Details()&trace.DriverBalancerEvents != 0 {
+			outlierTransitions.With(map[string]string{
+				"endpoint": info.Endpoint.Address(),
+				"node_id":  idToString(info.Endpoint.NodeID()),
+				"state":    info.State,
+				"reason":   info.Reason,
+			}).Inc()
+			value := float64(0)
+			if info.State != "healthy" {
+				value = 1
+			}
+			ejected.With(map[string]string{
+				"endpoint": info.Endpoint.Address(),
+				"node_id":  idToString(info.Endpoint.NodeID()),
+			}).Set(value)
+		}
+	}
 	t.OnBalancerUpdate = func(info trace.DriverBalancerUpdateStartInfo) func(trace.DriverBalancerUpdateDoneInfo) {
 		eventType := repeater.EventType(*info.Context)
 
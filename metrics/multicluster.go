@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/multicluster"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// WithMultiClusterTraces returns option of multi-cluster driver with metrics of routed calls
+// and health of clusters
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithMultiClusterTraces(config Config) multicluster.Option {
+	if config == nil {
+		return nil
+	}
+
+	return multicluster.WithTrace(multiCluster(config.WithSystem("ydb")))
+}
+
+func multiCluster(config Config) (t trace.MultiCluster) {
+	config = config.WithSystem("multicluster")
+	calls := config.CounterVec("calls", "cluster", "method", "read_only", "status")
+	healthy := config.GaugeVec("healthy", "cluster")
+	t.OnCall = func(info trace.MultiClusterCallStartInfo) func(trace.MultiClusterCallDoneInfo) {
+		if config.Details()&trace.MultiClusterEvents == 0 {
+			return nil
+		}
+		var (
+			cluster  = info.Cluster
+			method   = info.Method
+			readOnly = strconv.FormatBool(info.ReadOnly)
+		)
+
+		return func(info trace.MultiClusterCallDoneInfo) {
+			calls.With(map[string]string{
+				"cluster":   cluster,
+				"method":    method,
+				"read_only": readOnly,
+				"status":    errorBrief(info.Error),
+			}).Inc()
+		}
+	}
+	t.OnHealthChange = func(info trace.MultiClusterHealthChangeInfo) {
+		if config.Details()&trace.MultiClusterEvents == 0 {
+			return
+		}
+		value := 0.0
+		if info.Healthy {
+			value = 1
+		}
+		healthy.With(map[string]string{
+			"cluster": info.Cluster,
+		}).Set(value)
+	}
+
+	return t
+}
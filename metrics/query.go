@@ -0,0 +1,175 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+//nolint:funlen
+func query(config Config) (t trace.Query) {
+	config = config.WithSystem("query")
+
+	attemptsBuckets := []float64{0, 1, 2, 3, 4, 5, 7, 10}
+
+	// do metrics include DoTx calls, because DoTx emits OnDo event too
+	do := config.WithSystem("do")
+	doErrs := do.CounterVec("errors", "status", "label", "final")
+	doAttempts := do.HistogramVec("attempts", attemptsBuckets, "label")
+	doLatency := do.TimerVec("latency", "status", "label")
+
+	doTx := do.WithSystem("tx")
+	doTxErrs := doTx.CounterVec("errors", "status", "label", "final")
+	doTxAttempts := doTx.HistogramVec("attempts", attemptsBuckets, "label")
+	doTxLatency := doTx.TimerVec("latency", "status", "label")
+
+	pool := config.WithSystem("pool")
+	size := pool.GaugeVec("size", "node_id")
+
+	session := config.WithSystem("session")
+	sessionCreateLatency := session.WithSystem("create").TimerVec("latency", "status")
+	sessionDeleteLatency := session.WithSystem("delete").TimerVec("latency", "status")
+
+	execute := config.WithSystem("execute")
+	executeLatency := execute.TimerVec("latency", "status", "label")
+	resultBytes := config.WithSystem("result").HistogramVec("bytes",
+		[]float64{1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20, 4 << 20, 16 << 20},
+		"label",
+	)
+
+	t.OnDo = func(
+		info trace.QueryDoStartInfo,
+	) func(
+		trace.QueryDoIntermediateInfo,
+	) func(
+		trace.QueryDoDoneInfo,
+	) {
+		if config.Details()&trace.QueryPoolEvents == 0 {
+			return nil
+		}
+		label := info.Label
+		start := time.Now()
+
+		return func(info trace.QueryDoIntermediateInfo) func(trace.QueryDoDoneInfo) {
+			if info.Error != nil {
+				doErrs.With(map[string]string{
+					"status": errorBrief(info.Error),
+					"label":  label,
+					"final":  "false",
+				}).Inc()
+			}
+
+			return func(info trace.QueryDoDoneInfo) {
+				doAttempts.With(map[string]string{
+					"label": label,
+				}).Record(float64(info.Attempts))
+				doErrs.With(map[string]string{
+					"status": errorBrief(info.Error),
+					"label":  label,
+					"final":  "true",
+				}).Inc()
+				doLatency.With(map[string]string{
+					"status": errorBrief(info.Error),
+					"label":  label,
+				}).Record(time.Since(start))
+			}
+		}
+	}
+	t.OnDoTx = func(
+		info trace.QueryDoTxStartInfo,
+	) func(
+		trace.QueryDoTxIntermediateInfo,
+	) func(
+		trace.QueryDoTxDoneInfo,
+	) {
+		if config.Details()&trace.QueryPoolEvents == 0 {
+			return nil
+		}
+		label := info.Label
+		start := time.Now()
+
+		return func(info trace.QueryDoTxIntermediateInfo) func(trace.QueryDoTxDoneInfo) {
+			if info.Error != nil {
+				doTxErrs.With(map[string]string{
+					"status": errorBrief(info.Error),
+					"label":  label,
+					"final":  "false",
+				}).Inc()
+			}
+
+			return func(info trace.QueryDoTxDoneInfo) {
+				doTxAttempts.With(map[string]string{
+					"label": label,
+				}).Record(float64(info.Attempts))
+				doTxErrs.With(map[string]string{
+					"status": errorBrief(info.Error),
+					"label":  label,
+					"final":  "true",
+				}).Inc()
+				doTxLatency.With(map[string]string{
+					"status": errorBrief(info.Error),
+					"label":  label,
+				}).Record(time.Since(start))
+			}
+		}
+	}
+	t.OnSessionCreate = func(info trace.QuerySessionCreateStartInfo) func(trace.QuerySessionCreateDoneInfo) {
+		start := time.Now()
+
+		return func(info trace.QuerySessionCreateDoneInfo) {
+			if config.Details()&trace.QuerySessionEvents != 0 {
+				sessionCreateLatency.With(map[string]string{
+					"status": errorBrief(info.Error),
+				}).Record(time.Since(start))
+			}
+			if info.Error == nil && config.Details()&trace.QueryPoolEvents != 0 {
+				size.With(map[string]string{
+					"node_id": strconv.FormatInt(info.NodeID, 10),
+				}).Add(1)
+			}
+		}
+	}
+	t.OnSessionDelete = func(info trace.QuerySessionDeleteStartInfo) func(trace.QuerySessionDeleteDoneInfo) {
+		if config.Details()&trace.QuerySessionEvents == 0 {
+			return nil
+		}
+		start := time.Now()
+
+		return func(info trace.QuerySessionDeleteDoneInfo) {
+			sessionDeleteLatency.With(map[string]string{
+				"status": errorBrief(info.Error),
+			}).Record(time.Since(start))
+		}
+	}
+	t.OnSessionClose = func(info trace.QuerySessionCloseInfo) {
+		if config.Details()&trace.QueryPoolEvents != 0 {
+			size.With(map[string]string{
+				"node_id": strconv.FormatInt(info.NodeID, 10),
+			}).Add(-1)
+		}
+	}
+	t.OnSessionExecute = func(info trace.QuerySessionExecuteStartInfo) func(trace.QuerySessionExecuteDoneInfo) {
+		if config.Details()&trace.QueryExecuteEvents == 0 {
+			return nil
+		}
+		label := info.Label
+		start := time.Now()
+
+		return func(info trace.QuerySessionExecuteDoneInfo) {
+			executeLatency.With(map[string]string{
+				"status": errorBrief(info.Error),
+				"label":  label,
+			}).Record(time.Since(start))
+		}
+	}
+	t.OnResultNextPart = func(info trace.QueryResultNextPartInfo) {
+		if config.Details()&trace.QueryExecuteEvents != 0 {
+			resultBytes.With(map[string]string{
+				"label": info.Label,
+			}).Record(float64(info.BytesCount))
+		}
+	}
+
+	return t
+}
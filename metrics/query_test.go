@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestQueryPoolSize(t *testing.T) {
+	config := newTestConfig()
+	tracer := query(config)
+	size := func() float64 {
+		return config.gauges["query.pool.size"].gauges["node_id=1"].value
+	}
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		tracer.OnSessionCreate(trace.QuerySessionCreateStartInfo{Context: &ctx})(
+			trace.QuerySessionCreateDoneInfo{SessionID: "test", NodeID: 1},
+		)
+	}
+	require.Equal(t, 3.0, size())
+
+	tracer.OnSessionCreate(trace.QuerySessionCreateStartInfo{Context: &ctx})(
+		trace.QuerySessionCreateDoneInfo{NodeID: 1, Error: errors.New("test")},
+	)
+	require.Equal(t, 3.0, size())
+
+	tracer.OnSessionClose(trace.QuerySessionCloseInfo{SessionID: "test", NodeID: 1})
+	require.Equal(t, 2.0, size())
+}
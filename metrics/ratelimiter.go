@@ -1,9 +1,64 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func ratelimiter(config Config) (t trace.Ratelimiter) {
+	config = config.WithSystem("ratelimiter")
+
+	acquire := config.WithSystem("acquire")
+	acquireLatency := acquire.TimerVec("latency", "status", "resource", "mode")
+	acquireUnits := acquire.HistogramVec("units",
+		[]float64{1, 10, 100, 1000, 10000, 100000},
+		"resource", "mode",
+	)
+
+	limiter := config.WithSystem("limiter")
+	limiterSpends := limiter.CounterVec("spends", "resource", "source")
+
+	t.OnAcquireResource = func(
+		info trace.RatelimiterAcquireResourceStartInfo,
+	) func(
+		trace.RatelimiterAcquireResourceDoneInfo,
+	) {
+		if config.Details()&trace.RatelimiterEvents == 0 {
+			return nil
+		}
+		resource := info.ResourcePath
+		mode := "acquire"
+		if info.Report {
+			mode = "report"
+		}
+		acquireUnits.With(map[string]string{
+			"resource": resource,
+			"mode":     mode,
+		}).Record(float64(info.Amount))
+		start := time.Now()
+
+		return func(info trace.RatelimiterAcquireResourceDoneInfo) {
+			acquireLatency.With(map[string]string{
+				"status":   errorBrief(info.Error),
+				"resource": resource,
+				"mode":     mode,
+			}).Record(time.Since(start))
+		}
+	}
+	t.OnLimiterSpend = func(info trace.RatelimiterLimiterSpendInfo) {
+		if config.Details()&trace.RatelimiterEvents == 0 {
+			return
+		}
+		source := "remote"
+		if info.Local {
+			source = "local"
+		}
+		limiterSpends.With(map[string]string{
+			"resource": info.ResourcePath,
+			"source":   source,
+		}).Inc()
+	}
+
 	return t
 }
@@ -11,6 +11,10 @@ func retry(config Config) (t trace.Retry) {
 	errs := config.CounterVec("errors", "status", "retry_label", "final")
 	attempts := config.HistogramVec("attempts", []float64{0, 1, 2, 3, 4, 5, 7, 10}, "retry_label")
 	latency := config.TimerVec("latency", "retry_label")
+	hedges := config.CounterVec("hedges", "retry_label", "outcome")
+	budgetTokens := config.GaugeVec("budget_tokens")
+	budgetCapacity := config.GaugeVec("budget_capacity")
+	budgetRejects := config.CounterVec("budget_rejects", "retry_label")
 	t.OnRetry = func(info trace.RetryLoopStartInfo) func(trace.RetryLoopIntermediateInfo) func(trace.RetryLoopDoneInfo) {
 		label := info.Label
 		if label == "" {
@@ -45,5 +49,47 @@ func retry(config Config) (t trace.Retry) {
 		}
 	}
 
+	t.OnHedge = func(info trace.RetryHedgeStartInfo) func(trace.RetryHedgeDoneInfo) {
+		label := info.Label
+		if label == "" || config.Details()&trace.RetryEvents == 0 {
+			return nil
+		}
+		if !info.Allowed {
+			hedges.With(map[string]string{
+				"retry_label": label,
+				"outcome":     "budget_exhausted",
+			}).Inc()
+
+			return nil
+		}
+
+		return func(info trace.RetryHedgeDoneInfo) {
+			outcome := "primary_won"
+			switch {
+			case info.Error != nil:
+				outcome = "failed"
+			case info.HedgeWon:
+				outcome = "hedge_won"
+			}
+			hedges.With(map[string]string{
+				"retry_label": label,
+				"outcome":     outcome,
+			}).Inc()
+		}
+	}
+
+	t.OnBudget = func(info trace.RetryBudgetInfo) {
+		if config.Details()&trace.RetryEvents == 0 {
+			return
+		}
+		budgetTokens.With(nil).Set(info.Tokens)
+		budgetCapacity.With(nil).Set(info.Capacity)
+		if !info.Allowed {
+			budgetRejects.With(map[string]string{
+				"retry_label": info.Label,
+			}).Inc()
+		}
+	}
+
 	return t
 }
@@ -0,0 +1,235 @@
+package metrics
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+//nolint:funlen
+func topic(config Config) (t trace.Topic) {
+	config = config.WithSystem("topic")
+
+	// messages and bytes counted by histograms of batches, sum of histogram is total count
+	batchMessagesBuckets := []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000}
+	batchBytesBuckets := []float64{1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20, 4 << 20, 16 << 20}
+
+	reader := config.WithSystem("reader")
+	readMessages := reader.HistogramVec("messages", batchMessagesBuckets, "topic", "consumer", "partition")
+	readBytes := reader.HistogramVec("bytes", batchBytesBuckets, "topic", "consumer", "partition")
+	readerBufferFree := reader.GaugeVec("buffer_free_bytes", "topic", "consumer")
+	readerReconnects := reader.CounterVec("reconnects", "status", "topic", "consumer")
+	commitLatency := reader.TimerVec("commit_latency", "status", "topic", "consumer", "partition")
+	partitionsStarted := reader.CounterVec("partition_sessions_started", "status", "topic", "consumer", "partition")
+	partitionsStopped := reader.CounterVec("partition_sessions_stopped", "graceful", "topic", "consumer", "partition")
+
+	writer := config.WithSystem("writer")
+	writeMessages := writer.HistogramVec("messages", batchMessagesBuckets, "topic")
+	writeBytes := writer.HistogramVec("bytes", batchBytesBuckets, "topic")
+	writerQueueMessages := writer.GaugeVec("queue_messages", "topic")
+	writerInflightBytes := writer.GaugeVec("inflight_bytes", "topic")
+	writerReconnects := writer.CounterVec("reconnects", "status", "topic")
+	compressLatency := writer.TimerVec("compress_latency", "status", "topic", "codec", "reason")
+
+	t.OnReaderReadMessages = func(
+		info trace.TopicReaderReadMessagesStartInfo,
+	) func(
+		trace.TopicReaderReadMessagesDoneInfo,
+	) {
+		return func(info trace.TopicReaderReadMessagesDoneInfo) {
+			if config.Details()&trace.TopicReaderMessageEvents == 0 {
+				return
+			}
+			if info.Error != nil {
+				return
+			}
+			readerBufferFree.With(map[string]string{
+				"topic":    info.Topic,
+				"consumer": info.Consumer,
+			}).Set(float64(info.FreeBufferCapacity))
+			labels := map[string]string{
+				"topic":     info.Topic,
+				"consumer":  info.Consumer,
+				"partition": partitionToString(info.PartitionID),
+			}
+			readMessages.With(labels).Record(float64(info.MessagesCount))
+			readBytes.With(labels).Record(float64(info.BytesCount))
+		}
+	}
+	t.OnReaderReconnect = func(info trace.TopicReaderReconnectStartInfo) func(trace.TopicReaderReconnectDoneInfo) {
+		if config.Details()&trace.TopicReaderStreamLifeCycleEvents != 0 {
+			// reader may read several topics
+			readerReconnects.With(map[string]string{
+				"status":   errorBrief(info.Reason),
+				"topic":    strings.Join(info.Topics, ","),
+				"consumer": info.Consumer,
+			}).Inc()
+		}
+
+		return nil
+	}
+	t.OnReaderCommit = func(info trace.TopicReaderCommitStartInfo) func(trace.TopicReaderCommitDoneInfo) {
+		if config.Details()&trace.TopicReaderStreamEvents == 0 {
+			return nil
+		}
+		start := time.Now()
+
+		return func(doneInfo trace.TopicReaderCommitDoneInfo) {
+			commitLatency.With(map[string]string{
+				"status":    errorBrief(doneInfo.Error),
+				"topic":     info.Topic,
+				"consumer":  info.Consumer,
+				"partition": partitionToString(info.PartitionID),
+			}).Record(time.Since(start))
+		}
+	}
+	t.OnReaderPartitionReadStartResponse = func(
+		info trace.TopicReaderPartitionReadStartResponseStartInfo,
+	) func(
+		trace.TopicReaderPartitionReadStartResponseDoneInfo,
+	) {
+		if config.Details()&trace.TopicReaderPartitionEvents == 0 {
+			return nil
+		}
+
+		return func(doneInfo trace.TopicReaderPartitionReadStartResponseDoneInfo) {
+			partitionsStarted.With(map[string]string{
+				"status":    errorBrief(doneInfo.Error),
+				"topic":     info.Topic,
+				"consumer":  info.Consumer,
+				"partition": partitionToString(info.PartitionID),
+			}).Inc()
+		}
+	}
+	t.OnReaderPartitionReadStopResponse = func(
+		info trace.TopicReaderPartitionReadStopResponseStartInfo,
+	) func(
+		trace.TopicReaderPartitionReadStopResponseDoneInfo,
+	) {
+		if config.Details()&trace.TopicReaderPartitionEvents != 0 {
+			partitionsStopped.With(map[string]string{
+				"graceful":  strconv.FormatBool(info.Graceful),
+				"topic":     info.Topic,
+				"consumer":  info.Consumer,
+				"partition": partitionToString(info.PartitionID),
+			}).Inc()
+		}
+
+		return nil
+	}
+
+	t.OnWriterReconnect = func(info trace.TopicWriterReconnectStartInfo) func(trace.TopicWriterReconnectDoneInfo) {
+		if config.Details()&trace.TopicWriterStreamLifeCycleEvents == 0 {
+			return nil
+		}
+
+		return func(doneInfo trace.TopicWriterReconnectDoneInfo) {
+			writerReconnects.With(map[string]string{
+				"status": errorBrief(doneInfo.Error),
+				"topic":  info.Topic,
+			}).Inc()
+		}
+	}
+	t.OnWriterCompressMessages = func(
+		info trace.TopicWriterCompressMessagesStartInfo,
+	) func(
+		trace.TopicWriterCompressMessagesDoneInfo,
+	) {
+		if config.Details()&trace.TopicWriterStreamEvents == 0 {
+			return nil
+		}
+		start := time.Now()
+
+		return func(doneInfo trace.TopicWriterCompressMessagesDoneInfo) {
+			compressLatency.With(map[string]string{
+				"status": errorBrief(doneInfo.Error),
+				"topic":  info.Topic,
+				"codec":  strconv.Itoa(int(info.Codec)),
+				"reason": info.Reason.String(),
+			}).Record(time.Since(start))
+		}
+	}
+	t.OnWriterSendMessages = func(
+		info trace.TopicWriterSendMessagesStartInfo,
+	) func(
+		trace.TopicWriterSendMessagesDoneInfo,
+	) {
+		if config.Details()&trace.TopicWriterStreamEvents == 0 {
+			return nil
+		}
+
+		return func(doneInfo trace.TopicWriterSendMessagesDoneInfo) {
+			if doneInfo.Error != nil {
+				return
+			}
+			labels := map[string]string{
+				"topic": info.Topic,
+			}
+			writeMessages.With(labels).Record(float64(info.MessagesCount))
+			writeBytes.With(labels).Record(float64(info.BytesCount))
+		}
+	}
+
+	// gauges are sum for all writers of the topic, store last state of every writer for apply deltas.
+	// Empty queue state is not stored: closed writer emit empty state, so its state is removed
+	type writerQueueState struct {
+		topic         string
+		messagesCount int
+		bytesCount    int
+	}
+	var (
+		writerQueuesMutex sync.Mutex
+		writerQueues      = make(map[string]writerQueueState)
+	)
+	applyWriterQueueState := func(writerInstanceID string, state writerQueueState) {
+		writerQueuesMutex.Lock()
+		defer writerQueuesMutex.Unlock()
+
+		prev, has := writerQueues[writerInstanceID]
+		if !has && state.messagesCount == 0 && state.bytesCount == 0 {
+			return
+		}
+		if state.topic == "" {
+			state.topic = prev.topic
+		}
+		if state.messagesCount == 0 && state.bytesCount == 0 {
+			delete(writerQueues, writerInstanceID)
+		} else {
+			writerQueues[writerInstanceID] = state
+		}
+
+		labels := map[string]string{
+			"topic": state.topic,
+		}
+		writerQueueMessages.With(labels).Add(float64(state.messagesCount - prev.messagesCount))
+		writerInflightBytes.With(labels).Add(float64(state.bytesCount - prev.bytesCount))
+	}
+	t.OnWriterQueueChanged = func(info trace.TopicWriterQueueChangedInfo) {
+		if config.Details()&trace.TopicWriterStreamEvents != 0 {
+			applyWriterQueueState(info.WriterInstanceID, writerQueueState{
+				topic:         info.Topic,
+				messagesCount: info.MessagesCount,
+				bytesCount:    info.BytesCount,
+			})
+		}
+	}
+	t.OnWriterClose = func(info trace.TopicWriterCloseStartInfo) func(trace.TopicWriterCloseDoneInfo) {
+		if config.Details()&trace.TopicWriterStreamEvents == 0 {
+			return nil
+		}
+
+		return func(trace.TopicWriterCloseDoneInfo) {
+			// messages of closed writer are not waited ack anymore
+			applyWriterQueueState(info.WriterInstanceID, writerQueueState{})
+		}
+	}
+
+	return t
+}
+
+func partitionToString(partitionID int64) string {
+	return strconv.FormatInt(partitionID, 10)
+}
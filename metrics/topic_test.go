@@ -0,0 +1,178 @@
+package metrics
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type testGauge struct {
+	value float64
+}
+
+func (g *testGauge) Add(delta float64) {
+	g.value += delta
+}
+
+func (g *testGauge) Set(value float64) {
+	g.value = value
+}
+
+type testNoop struct{}
+
+func (testNoop) Inc()           {}
+func (testNoop) Record(float64) {}
+
+type testTimer struct{}
+
+func (testTimer) Record(time.Duration) {}
+
+type testGaugeVec struct {
+	gauges map[string]*testGauge
+}
+
+func (v *testGaugeVec) With(labels map[string]string) Gauge {
+	key := labelsKey(labels)
+	if v.gauges[key] == nil {
+		v.gauges[key] = &testGauge{}
+	}
+
+	return v.gauges[key]
+}
+
+type testCounterVec struct{}
+
+func (testCounterVec) With(map[string]string) Counter {
+	return testNoop{}
+}
+
+type testHistogramVec struct{}
+
+func (testHistogramVec) With(map[string]string) Histogram {
+	return testNoop{}
+}
+
+type testTimerVec struct{}
+
+func (testTimerVec) With(map[string]string) Timer {
+	return testTimer{}
+}
+
+type testConfig struct {
+	prefix string
+	gauges map[string]*testGaugeVec
+}
+
+func newTestConfig() *testConfig {
+	return &testConfig{gauges: make(map[string]*testGaugeVec)}
+}
+
+func (c *testConfig) CounterVec(string, ...string) CounterVec {
+	return testCounterVec{}
+}
+
+func (c *testConfig) GaugeVec(name string, _ ...string) GaugeVec {
+	name = c.prefix + name
+	if c.gauges[name] == nil {
+		c.gauges[name] = &testGaugeVec{gauges: make(map[string]*testGauge)}
+	}
+
+	return c.gauges[name]
+}
+
+func (c *testConfig) TimerVec(string, ...string) TimerVec {
+	return testTimerVec{}
+}
+
+func (c *testConfig) HistogramVec(string, []float64, ...string) HistogramVec {
+	return testHistogramVec{}
+}
+
+func (c *testConfig) Details() trace.Details {
+	return trace.DetailsAll
+}
+
+func (c *testConfig) WithSystem(subsystem string) Config {
+	return &testConfig{prefix: c.prefix + subsystem + ".", gauges: c.gauges}
+}
+
+func labelsKey(labels map[string]string) string {
+	res := make([]string, 0, len(labels))
+	for k, v := range labels {
+		res = append(res, k+"="+v)
+	}
+	sort.Strings(res)
+
+	return strings.Join(res, ",")
+}
+
+func TestTopicWriterQueueGauges(t *testing.T) {
+	config := newTestConfig()
+	tracer := topic(config)
+	queueMessages := func() float64 {
+		return config.gauges["topic.writer.queue_messages"].gauges["topic=test"].value
+	}
+	inflightBytes := func() float64 {
+		return config.gauges["topic.writer.inflight_bytes"].gauges["topic=test"].value
+	}
+
+	tracer.OnWriterQueueChanged(trace.TopicWriterQueueChangedInfo{
+		WriterInstanceID: "1", Topic: "test", MessagesCount: 2, BytesCount: 20,
+	})
+	tracer.OnWriterQueueChanged(trace.TopicWriterQueueChangedInfo{
+		WriterInstanceID: "2", Topic: "test", MessagesCount: 3, BytesCount: 30,
+	})
+	require.Equal(t, 5.0, queueMessages())
+	require.Equal(t, 50.0, inflightBytes())
+
+	tracer.OnWriterQueueChanged(trace.TopicWriterQueueChangedInfo{
+		WriterInstanceID: "1", Topic: "test", MessagesCount: 1, BytesCount: 10,
+	})
+	require.Equal(t, 4.0, queueMessages())
+	require.Equal(t, 40.0, inflightBytes())
+
+	closeWriter := func(writerInstanceID string) {
+		tracer.OnWriterClose(trace.TopicWriterCloseStartInfo{WriterInstanceID: writerInstanceID})(
+			trace.TopicWriterCloseDoneInfo{},
+		)
+	}
+
+	closeWriter("2")
+	require.Equal(t, 1.0, queueMessages())
+	require.Equal(t, 10.0, inflightBytes())
+
+	// close unknown writer
+	closeWriter("3")
+	require.Equal(t, 1.0, queueMessages())
+
+	// close writer with pending messages, closed writer emit empty queue state
+	closeWriter("1")
+	tracer.OnWriterQueueChanged(trace.TopicWriterQueueChangedInfo{
+		WriterInstanceID: "1", Topic: "test",
+	})
+	require.Equal(t, 0.0, queueMessages())
+	require.Equal(t, 0.0, inflightBytes())
+
+	// empty state of new writer is not stored and not touch gauges
+	tracer.OnWriterQueueChanged(trace.TopicWriterQueueChangedInfo{
+		WriterInstanceID: "4", Topic: "other",
+	})
+	require.NotContains(t, config.gauges["topic.writer.queue_messages"].gauges, "topic=other")
+}
+
+func TestTopicReaderBufferGauge(t *testing.T) {
+	config := newTestConfig()
+	tracer := topic(config)
+
+	tracer.OnReaderReadMessages(trace.TopicReaderReadMessagesStartInfo{})(trace.TopicReaderReadMessagesDoneInfo{
+		Topic: "test", Consumer: "consumer", FreeBufferCapacity: 100,
+	})
+	require.Equal(t, 100.0,
+		config.gauges["topic.reader.buffer_free_bytes"].gauges["consumer=consumer,topic=test"].value,
+	)
+}
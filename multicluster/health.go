@@ -0,0 +1,78 @@
+package multicluster
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+var errNoEndpoints = xerrors.Wrap(errors.New("ydb: multicluster: no endpoints"))
+
+func (d *Driver) healthChecks() {
+	defer d.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-d.done
+		cancel()
+	}()
+
+	ticker := time.NewTicker(d.config.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.done:
+			return
+		case <-ticker.C:
+			d.probe(ctx)
+		}
+	}
+}
+
+// probe makes health probes of all clusters concurrently
+func (d *Driver) probe(ctx context.Context) {
+	var wg sync.WaitGroup
+	wg.Add(len(d.clusters))
+	for _, c := range d.clusters {
+		go func(c *clusterState) {
+			defer wg.Done()
+			d.probeCluster(ctx, c)
+		}(c)
+	}
+	wg.Wait()
+}
+
+func (d *Driver) probeCluster(ctx context.Context, c *clusterState) {
+	if d.config.healthCheckTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.config.healthCheckTimeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	err := d.config.healthCheck(ctx, c.Connection)
+	latency := time.Since(start)
+
+	if err != nil && d.closed() {
+		return
+	}
+
+	c.mu.Lock()
+	changed := c.healthy != (err == nil)
+	c.healthy = err == nil
+	if err == nil {
+		c.latency = latency
+	}
+	c.mu.Unlock()
+
+	if changed {
+		trace.MultiClusterOnHealthChange(d.config.trace, c.Name, err == nil, latency, err)
+	}
+}
@@ -0,0 +1,244 @@
+// Package multicluster provides driver over several YDB clusters (for example, primary database and
+// async replica in other region) with routing of writes to healthy primary cluster, routing of
+// read-only operations to nearest healthy cluster and automatic failover by health probes.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+package multicluster
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/discovery"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+var (
+	errNoClusters = xerrors.Wrap(errors.New("ydb: multicluster: no clusters"))
+	errNoPrimary  = xerrors.Wrap(errors.New("ydb: multicluster: no primary clusters"))
+	errClosed     = xerrors.Wrap(errors.New("ydb: multicluster: driver closed"))
+)
+
+// Connection is a connection to one cluster. *ydb.Driver implements Connection
+type Connection interface {
+	Table() table.Client
+	Query() query.Client
+	Topic() topic.Client
+	Discovery() discovery.Client
+}
+
+// Cluster describes one cluster of multi-cluster driver
+type Cluster struct {
+	// Name is unique name of cluster, which is reported in trace events
+	Name string
+
+	Connection Connection
+
+	// Primary cluster accepts writes. Writes are routed to first healthy primary cluster
+	// in order of clusters, so other primary clusters are failover clusters
+	Primary bool
+}
+
+type clusterState struct {
+	Cluster
+
+	mu      sync.RWMutex
+	healthy bool
+	latency time.Duration
+}
+
+func (c *clusterState) health() (healthy bool, latency time.Duration) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.healthy, c.latency
+}
+
+// Driver is a driver over several clusters. Driver exposes Table(), Query() and Topic() clients,
+// which choose cluster on each call:
+//   - read-only operations are routed to nearest (by latency of health probes) healthy cluster
+//   - other operations are routed to first healthy primary cluster
+//
+// If no cluster is healthy - first primary cluster is used.
+type Driver struct {
+	clusters []*clusterState
+	config   config
+
+	table tableClient
+	query queryClient
+	topic topicClient
+
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// New creates multi-cluster driver. New makes first round of health probes and starts background
+// health probes. Close of multi-cluster driver stops health probes, but not closes connections of clusters
+func New(ctx context.Context, clusters []Cluster, opts ...Option) (*Driver, error) {
+	if len(clusters) == 0 {
+		return nil, xerrors.WithStackTrace(errNoClusters)
+	}
+
+	d := &Driver{
+		clusters: make([]*clusterState, 0, len(clusters)),
+		config:   defaultConfig(),
+		done:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&d.config)
+		}
+	}
+
+	hasPrimary := false
+	for _, c := range clusters {
+		hasPrimary = hasPrimary || c.Primary
+		d.clusters = append(d.clusters, &clusterState{
+			Cluster: c,
+			healthy: true,
+		})
+	}
+	if !hasPrimary {
+		return nil, xerrors.WithStackTrace(errNoPrimary)
+	}
+
+	d.table = tableClient{d: d}
+	d.query = queryClient{d: d}
+	d.topic = topicClient{d: d}
+
+	d.probe(ctx)
+
+	if d.config.healthCheckInterval > 0 {
+		d.wg.Add(1)
+		go d.healthChecks()
+	}
+
+	return d, nil
+}
+
+// Table returns table client, which routes calls to clusters
+func (d *Driver) Table() table.Client {
+	return &d.table
+}
+
+// Query returns query client, which routes calls to clusters
+func (d *Driver) Query() query.Client {
+	return &d.query
+}
+
+// Topic returns topic client, which routes calls to primary cluster
+func (d *Driver) Topic() topic.Client {
+	return &d.topic
+}
+
+// Healthy returns names of healthy clusters
+func (d *Driver) Healthy() []string {
+	var names []string
+	for _, c := range d.clusters {
+		if healthy, _ := c.health(); healthy {
+			names = append(names, c.Name)
+		}
+	}
+
+	return names
+}
+
+// Close stops health probes. Connections of clusters must be closed by caller
+func (d *Driver) Close(ctx context.Context) error {
+	d.closeOnce.Do(func() {
+		close(d.done)
+	})
+
+	wait := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(wait)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return xerrors.WithStackTrace(ctx.Err())
+	case <-wait:
+		return nil
+	}
+}
+
+func (d *Driver) closed() bool {
+	select {
+	case <-d.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// primary returns first healthy primary cluster or first primary cluster if all primary clusters are unhealthy
+func (d *Driver) primary() *clusterState {
+	var first *clusterState
+	for _, c := range d.clusters {
+		if !c.Primary {
+			continue
+		}
+		if first == nil {
+			first = c
+		}
+		if healthy, _ := c.health(); healthy {
+			return c
+		}
+	}
+
+	return first
+}
+
+// nearest returns healthy cluster with minimal latency or primary cluster if all clusters are unhealthy
+func (d *Driver) nearest() *clusterState {
+	var (
+		nearest *clusterState
+		minimal time.Duration
+	)
+	for _, c := range d.clusters {
+		if healthy, latency := c.health(); healthy && (nearest == nil || latency < minimal) {
+			nearest, minimal = c, latency
+		}
+	}
+	if nearest == nil {
+		return d.primary()
+	}
+
+	return nearest
+}
+
+type caller interface {
+	FunctionID() string
+}
+
+// call routes call to cluster and reports chosen cluster to trace
+func (d *Driver) call(
+	ctx context.Context, fid caller, method string, readOnly bool,
+	f func(ctx context.Context, c *clusterState) error,
+) (err error) {
+	c := d.primary()
+	if readOnly {
+		c = d.nearest()
+	}
+
+	onDone := trace.MultiClusterOnCall(d.config.trace, &ctx, fid, method, c.Name, readOnly)
+	defer func() {
+		onDone(err)
+	}()
+
+	if d.closed() {
+		return xerrors.WithStackTrace(errClosed)
+	}
+
+	return f(ctx, c)
+}
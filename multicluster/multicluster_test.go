@@ -0,0 +1,211 @@
+package multicluster
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/discovery"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+var _ Connection = (*ydb.Driver)(nil)
+
+type testTableClient struct {
+	table.Client
+
+	name  string
+	calls *[]string
+}
+
+func (c *testTableClient) Do(ctx context.Context, op table.Operation, opts ...table.Option) error {
+	*c.calls = append(*c.calls, c.name)
+
+	return nil
+}
+
+func (c *testTableClient) DoTx(ctx context.Context, op table.TxOperation, opts ...table.Option) error {
+	*c.calls = append(*c.calls, c.name)
+
+	return nil
+}
+
+type testQueryClient struct {
+	query.Client
+
+	name  string
+	calls *[]string
+}
+
+func (c *testQueryClient) DoTx(ctx context.Context, op query.TxOperation, opts ...options.DoTxOption) error {
+	*c.calls = append(*c.calls, c.name)
+
+	return nil
+}
+
+type testConnection struct {
+	table testTableClient
+	query testQueryClient
+}
+
+func (c *testConnection) Table() table.Client {
+	return &c.table
+}
+
+func (c *testConnection) Query() query.Client {
+	return &c.query
+}
+
+func (c *testConnection) Topic() topic.Client {
+	return nil
+}
+
+func (c *testConnection) Discovery() discovery.Client {
+	return nil
+}
+
+func newTestConnection(name string, calls *[]string) *testConnection {
+	return &testConnection{
+		table: testTableClient{name: name, calls: calls},
+		query: testQueryClient{name: name, calls: calls},
+	}
+}
+
+type testHealth struct {
+	mu        sync.Mutex
+	unhealthy map[string]bool
+	names     map[Connection]string
+}
+
+func (h *testHealth) check(ctx context.Context, c Connection) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.unhealthy[h.names[c]] {
+		return errors.New("unhealthy")
+	}
+
+	return nil
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	t.Run("NoClusters", func(t *testing.T) {
+		_, err := New(ctx, nil)
+		require.ErrorIs(t, err, errNoClusters)
+	})
+	t.Run("NoPrimary", func(t *testing.T) {
+		_, err := New(ctx, []Cluster{{Name: "a", Connection: &testConnection{}}})
+		require.ErrorIs(t, err, errNoPrimary)
+	})
+}
+
+func TestRouting(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		calls   []string
+		primary = newTestConnection("primary", &calls)
+		standby = newTestConnection("standby", &calls)
+		replica = newTestConnection("replica", &calls)
+		health  = &testHealth{
+			unhealthy: map[string]bool{},
+			names:     map[Connection]string{primary: "primary", standby: "standby", replica: "replica"},
+		}
+		events   []string
+		eventsMu sync.Mutex
+	)
+	d, err := New(ctx, []Cluster{
+		{Name: "primary", Connection: primary, Primary: true},
+		{Name: "standby", Connection: standby, Primary: true},
+		{Name: "replica", Connection: replica},
+	},
+		WithHealthCheck(health.check),
+		WithHealthCheckInterval(0),
+		WithTrace(trace.MultiCluster{
+			OnHealthChange: func(info trace.MultiClusterHealthChangeInfo) {
+				eventsMu.Lock()
+				defer eventsMu.Unlock()
+				events = append(events, info.Cluster+": "+map[bool]string{true: "healthy", false: "unhealthy"}[info.Healthy])
+			},
+		}),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, d.Close(ctx))
+	}()
+	require.Equal(t, []string{"primary", "standby", "replica"}, d.Healthy())
+
+	// nearest cluster for read-only calls
+	for _, c := range d.clusters {
+		c.latency = map[string]time.Duration{"primary": 3, "standby": 2, "replica": 1}[c.Name]
+	}
+
+	require.NoError(t, d.Table().Do(ctx, nil))
+	require.NoError(t, d.Table().Do(WithReadOnly(ctx), nil))
+	require.NoError(t, d.Table().DoTx(ctx, nil))
+	require.NoError(t, d.Table().DoTx(ctx, nil,
+		table.WithTxSettings(table.TxSettings(table.WithStaleReadOnly())),
+	))
+	require.NoError(t, d.Query().DoTx(ctx, nil))
+	require.NoError(t, d.Query().DoTx(ctx, nil,
+		query.WithTxSettings(query.TxSettings(query.WithSnapshotReadOnly())),
+	))
+	// online read-only transaction must see latest data of primary
+	require.NoError(t, d.Table().DoTx(ctx, nil,
+		table.WithTxSettings(table.TxSettings(table.WithOnlineReadOnly())),
+	))
+	require.NoError(t, d.Query().DoTx(ctx, nil,
+		query.WithTxSettings(query.TxSettings(query.WithOnlineReadOnly())),
+	))
+	require.Equal(t, []string{
+		"primary", "replica", "primary", "replica", "primary", "replica", "primary", "primary",
+	}, calls)
+
+	// failover
+	calls = nil
+	health.unhealthy["primary"] = true
+	health.unhealthy["replica"] = true
+	d.probe(ctx)
+	require.Equal(t, []string{"standby"}, d.Healthy())
+	require.NoError(t, d.Table().Do(ctx, nil))
+	require.NoError(t, d.Table().Do(WithReadOnly(ctx), nil))
+
+	// all clusters are unhealthy
+	health.unhealthy["standby"] = true
+	d.probe(ctx)
+	require.Empty(t, d.Healthy())
+	require.NoError(t, d.Table().Do(WithReadOnly(ctx), nil))
+	require.Equal(t, []string{"standby", "standby", "primary"}, calls)
+
+	require.ElementsMatch(t, []string{
+		"primary: unhealthy",
+		"replica: unhealthy",
+		"standby: unhealthy",
+	}, events)
+}
+
+func TestClose(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		calls []string
+	)
+	d, err := New(ctx, []Cluster{
+		{Name: "primary", Connection: newTestConnection("primary", &calls), Primary: true},
+	}, WithHealthCheck(func(ctx context.Context, c Connection) error {
+		return nil
+	}))
+	require.NoError(t, err)
+	require.NoError(t, d.Close(ctx))
+	require.NoError(t, d.Close(ctx))
+	require.ErrorIs(t, d.Table().Do(ctx, nil), errClosed)
+	require.Empty(t, calls)
+}
@@ -0,0 +1,75 @@
+package multicluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const (
+	defaultHealthCheckInterval = 5 * time.Second
+	defaultHealthCheckTimeout  = time.Second
+)
+
+type config struct {
+	healthCheck         func(ctx context.Context, c Connection) error
+	healthCheckInterval time.Duration
+	healthCheckTimeout  time.Duration
+	trace               *trace.MultiCluster
+}
+
+func defaultConfig() config {
+	return config{
+		healthCheck:         discoveryHealthCheck,
+		healthCheckInterval: defaultHealthCheckInterval,
+		healthCheckTimeout:  defaultHealthCheckTimeout,
+		trace:               &trace.MultiCluster{},
+	}
+}
+
+// discoveryHealthCheck checks cluster by discovery of endpoints
+func discoveryHealthCheck(ctx context.Context, c Connection) error {
+	endpoints, err := c.Discovery().Discover(ctx)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if len(endpoints) == 0 {
+		return xerrors.WithStackTrace(errNoEndpoints)
+	}
+
+	return nil
+}
+
+// Option is option for New
+type Option func(c *config)
+
+// WithHealthCheck defines health probe of cluster, default health probe makes discovery of endpoints of cluster
+func WithHealthCheck(check func(ctx context.Context, c Connection) error) Option {
+	return func(c *config) {
+		c.healthCheck = check
+	}
+}
+
+// WithHealthCheckInterval defines interval between health probes, zero disables background health probes.
+// Default interval is 5s
+func WithHealthCheckInterval(interval time.Duration) Option {
+	return func(c *config) {
+		c.healthCheckInterval = interval
+	}
+}
+
+// WithHealthCheckTimeout defines timeout of health probe, default timeout is 1s
+func WithHealthCheckTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		c.healthCheckTimeout = timeout
+	}
+}
+
+// WithTrace appends trace of multi-cluster driver
+func WithTrace(t trace.MultiCluster, opts ...trace.MultiClusterComposeOption) Option {
+	return func(c *config) {
+		c.trace = c.trace.Compose(&t, opts...)
+	}
+}
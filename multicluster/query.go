@@ -0,0 +1,49 @@
+package multicluster
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+var _ query.Client = (*queryClient)(nil)
+
+type queryClient struct {
+	d *Driver
+}
+
+// isReadOnlyQueryTx checks transaction settings of query.DoTx options. Only stale and snapshot
+// read-only transactions may read from replica, online read-only transaction must see latest data
+func isReadOnlyQueryTx(opts ...options.DoTxOption) bool {
+	a := allocator.New()
+	defer a.Free()
+
+	switch options.ParseDoTxOpts(nil, opts...).TxSettings().ToYDB(a).GetTxMode().(type) {
+	case *Ydb_Query.TransactionSettings_StaleReadOnly,
+		*Ydb_Query.TransactionSettings_SnapshotReadOnly:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *queryClient) Do(ctx context.Context, op query.Operation, opts ...options.DoOption) error {
+	return c.d.call(ctx, stack.FunctionID(""), "query.Do", isReadOnly(ctx),
+		func(ctx context.Context, cluster *clusterState) error {
+			return cluster.Connection.Query().Do(ctx, op, opts...)
+		},
+	)
+}
+
+func (c *queryClient) DoTx(ctx context.Context, op query.TxOperation, opts ...options.DoTxOption) error {
+	return c.d.call(ctx, stack.FunctionID(""), "query.DoTx", isReadOnlyQueryTx(opts...),
+		func(ctx context.Context, cluster *clusterState) error {
+			return cluster.Connection.Query().DoTx(ctx, op, opts...)
+		},
+	)
+}
@@ -0,0 +1,18 @@
+package multicluster
+
+import "context"
+
+type ctxReadOnlyKey struct{}
+
+// WithReadOnly marks context of Do call as read-only operation, so call will be routed to nearest
+// healthy cluster. Read-only mode of DoTx call is detected by transaction settings: only stale and
+// snapshot read-only transactions are routed to nearest cluster
+func WithReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxReadOnlyKey{}, true)
+}
+
+func isReadOnly(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(ctxReadOnlyKey{}).(bool)
+
+	return readOnly
+}
@@ -0,0 +1,67 @@
+package multicluster
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+var _ table.Client = (*tableClient)(nil)
+
+type tableClient struct {
+	d *Driver
+}
+
+// isReadOnlyTableTx checks transaction settings of table.DoTx options. Only stale and snapshot
+// read-only transactions may read from replica, online read-only transaction must see latest data
+func isReadOnlyTableTx(opts ...table.Option) bool {
+	var options table.Options
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyTableOption(&options)
+		}
+	}
+	if options.TxSettings == nil {
+		return false
+	}
+
+	switch options.TxSettings.Settings().GetTxMode().(type) {
+	case *Ydb_Table.TransactionSettings_StaleReadOnly,
+		*Ydb_Table.TransactionSettings_SnapshotReadOnly:
+		return true
+	default:
+		return false
+	}
+}
+
+//nolint:staticcheck
+func (c *tableClient) CreateSession(ctx context.Context, opts ...table.Option) (s table.ClosableSession, err error) {
+	err = c.d.call(ctx, stack.FunctionID(""), "table.CreateSession", false,
+		func(ctx context.Context, cluster *clusterState) (err error) {
+			s, err = cluster.Connection.Table().CreateSession(ctx, opts...)
+
+			return err
+		},
+	)
+
+	return s, err
+}
+
+func (c *tableClient) Do(ctx context.Context, op table.Operation, opts ...table.Option) error {
+	return c.d.call(ctx, stack.FunctionID(""), "table.Do", isReadOnly(ctx),
+		func(ctx context.Context, cluster *clusterState) error {
+			return cluster.Connection.Table().Do(ctx, op, opts...)
+		},
+	)
+}
+
+func (c *tableClient) DoTx(ctx context.Context, op table.TxOperation, opts ...table.Option) error {
+	return c.d.call(ctx, stack.FunctionID(""), "table.DoTx", isReadOnlyTableTx(opts...),
+		func(ctx context.Context, cluster *clusterState) error {
+			return cluster.Connection.Table().DoTx(ctx, op, opts...)
+		},
+	)
+}
@@ -0,0 +1,85 @@
+package multicluster
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+var _ topic.Client = (*topicClient)(nil)
+
+// topicClient routes all calls to primary cluster, because topics are not replicated
+type topicClient struct {
+	d *Driver
+}
+
+func (c *topicClient) Alter(ctx context.Context, path string, opts ...topicoptions.AlterOption) error {
+	return c.d.call(ctx, stack.FunctionID(""), "topic.Alter", false,
+		func(ctx context.Context, cluster *clusterState) error {
+			return cluster.Connection.Topic().Alter(ctx, path, opts...)
+		},
+	)
+}
+
+func (c *topicClient) Create(ctx context.Context, path string, opts ...topicoptions.CreateOption) error {
+	return c.d.call(ctx, stack.FunctionID(""), "topic.Create", false,
+		func(ctx context.Context, cluster *clusterState) error {
+			return cluster.Connection.Topic().Create(ctx, path, opts...)
+		},
+	)
+}
+
+func (c *topicClient) Describe(
+	ctx context.Context, path string, opts ...topicoptions.DescribeOption,
+) (description topictypes.TopicDescription, err error) {
+	err = c.d.call(ctx, stack.FunctionID(""), "topic.Describe", false,
+		func(ctx context.Context, cluster *clusterState) (err error) {
+			description, err = cluster.Connection.Topic().Describe(ctx, path, opts...)
+
+			return err
+		},
+	)
+
+	return description, err
+}
+
+func (c *topicClient) Drop(ctx context.Context, path string, opts ...topicoptions.DropOption) error {
+	return c.d.call(ctx, stack.FunctionID(""), "topic.Drop", false,
+		func(ctx context.Context, cluster *clusterState) error {
+			return cluster.Connection.Topic().Drop(ctx, path, opts...)
+		},
+	)
+}
+
+func (c *topicClient) StartReader(
+	consumer string, readSelectors topicoptions.ReadSelectors, opts ...topicoptions.ReaderOption,
+) (reader *topicreader.Reader, err error) {
+	err = c.d.call(context.Background(), stack.FunctionID(""), "topic.StartReader", false,
+		func(ctx context.Context, cluster *clusterState) (err error) {
+			reader, err = cluster.Connection.Topic().StartReader(consumer, readSelectors, opts...)
+
+			return err
+		},
+	)
+
+	return reader, err
+}
+
+func (c *topicClient) StartWriter(
+	topicPath string, opts ...topicoptions.WriterOption,
+) (writer *topicwriter.Writer, err error) {
+	err = c.d.call(context.Background(), stack.FunctionID(""), "topic.StartWriter", false,
+		func(ctx context.Context, cluster *clusterState) (err error) {
+			writer, err = cluster.Connection.Topic().StartWriter(topicPath, opts...)
+
+			return err
+		},
+	)
+
+	return writer, err
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/log"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -105,6 +106,36 @@ func WithConnectionTTL(ttl time.Duration) Option {
 	}
 }
 
+// WithChannelsPerEndpoint defines count of grpc connections to each endpoint for unary calls.
+// Each call is routed to least loaded channel of endpoint. Default count is 1.
+// Connection TTL (see WithConnectionTTL) applies to each channel
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithChannelsPerEndpoint(channels int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithChannelsPerEndpoint(channels))
+
+		return nil
+	}
+}
+
+// WithStreamingChannelsPerEndpoint defines count of grpc connections to each endpoint for streaming
+// calls (scan queries, topic readers and writers, etc.), so heavy streams not share HTTP/2 connection
+// with unary calls. If count is zero (by default) - streaming calls share channels with unary calls
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithStreamingChannelsPerEndpoint(channels int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithStreamingChannelsPerEndpoint(channels))
+
+		return nil
+	}
+}
+
 // WithEndpoint defines endpoint option
 //
 // Warning: use ydb.Open with required Driver string parameter instead
@@ -233,6 +264,28 @@ func WithDialTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithDrainTimeout sets max duration of drain phase of Driver.Close. On drain phase new calls of
+// Do, DoTx, StartReader and StartWriter are rejected with ErrDriverClosing, in-flight calls and
+// transactions are allowed to finish, topic writers are flushed and closed. Topic readers may be
+// closed by application after commit of processed messages until half of drain timeout, remaining
+// readers are closed with flush of commits. Only after drain phase sessions are deleted and
+// connections are closed.
+// Context of Driver.Close also limits drain phase.
+//
+// Drain phase is disabled by default (config.DefaultDrainTimeout is zero), so Driver.Close closes
+// clients immediately as before. Zero or negative timeout skips drain phase
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithDrainTimeout(timeout time.Duration) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithDrainTimeout(timeout))
+
+		return nil
+	}
+}
+
 // With collects additional configuration options.
 //
 // This option does not replace collected option, instead it will append provided options.
@@ -268,6 +321,55 @@ func WithDiscoveryInterval(discoveryInterval time.Duration) Option {
 	}
 }
 
+// WithStaticEndpoints replaces discovery service with static list of endpoints (host:port).
+// If endpoints are empty - endpoint from connection string is used.
+// Node IDs of endpoints are resolved lazily with discovery service of any endpoint
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithStaticEndpoints(endpoints ...string) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithStaticDiscovery(endpoints...))
+
+		return nil
+	}
+}
+
+// WithDNSDiscovery replaces discovery service with resolving of endpoints from A/AAAA records of
+// hosts (host:port), which refreshed with discovery interval (see WithDiscoveryInterval).
+// If hosts are empty - endpoint from connection string is used.
+// Node IDs of endpoints are resolved lazily with discovery service of any endpoint
+//
+// Warning: endpoints are IP addresses, so for secure connection certificates must contain IP addresses
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithDNSDiscovery(hosts ...string) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithDNSDiscovery(hosts...))
+
+		return nil
+	}
+}
+
+// WithDNSSRVDiscovery replaces discovery service with resolving of endpoints from SRV records,
+// which refreshed with discovery interval (see WithDiscoveryInterval).
+// If names are empty - host of endpoint from connection string is used.
+// Node IDs of endpoints are resolved lazily with discovery service of any endpoint
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithDNSSRVDiscovery(names ...string) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithDNSSRVDiscovery(names...))
+
+		return nil
+	}
+}
+
 // WithTraceDriver appends trace.Driver into driver traces.
 func WithTraceDriver(t trace.Driver, opts ...trace.DriverComposeOption) Option { //nolint:gocritic
 	return func(ctx context.Context, c *Driver) error {
@@ -277,6 +379,48 @@ func WithTraceDriver(t trace.Driver, opts ...trace.DriverComposeOption) Option {
 	}
 }
 
+// WithRetryBudget defines shared retry budget of table, query and other clients, which prevents
+// retry storms on partial outage. If retry budget is exhausted - call returns error with retry.ErrBudgetExhausted
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithRetryBudget(b *retry.Budget) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithRetryBudget(b))
+
+		return nil
+	}
+}
+
+// WithTableRetryPolicy replaces default retry policy of table client calls.
+// Retry policy of call (defined with retry.WithPolicy) replaces retry policy of client
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithTableRetryPolicy(p retry.Policy) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithRetryPolicy(p))
+
+		return nil
+	}
+}
+
+// WithQueryRetryPolicy replaces default retry policy of query client calls.
+// Retry policy of call (defined with retry.WithPolicy) replaces retry policy of client
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithQueryRetryPolicy(p retry.Policy) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.queryOptions = append(c.queryOptions, queryConfig.WithRetryPolicy(p))
+
+		return nil
+	}
+}
+
 // WithTraceRetry appends trace.Retry into retry traces.
 func WithTraceRetry(t trace.Retry, opts ...trace.RetryComposeOption) Option {
 	return func(ctx context.Context, c *Driver) error {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -66,3 +67,12 @@ func WithTrace(t *trace.Query) bothDoAndDoTxOption {
 func WithLabel(lbl string) bothDoAndDoTxOption {
 	return options.WithLabel(lbl)
 }
+
+// WithRetryOptions appends options of retry loop, for example retry.WithHedging
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithRetryOptions(opts ...retry.Option) bothDoAndDoTxOption {
+	return options.WithRetryOptions(opts...)
+}
@@ -50,7 +50,7 @@ const (
 	StatsModeProfile = options.StatsModeProfile
 )
 
-func WithParameters(parameters *params.Parameters) options.ExecuteOption {
+func WithParameters(parameters *params.Parameters) options.ParametersOption {
 	return options.WithParameters(parameters)
 }
 
@@ -80,3 +80,31 @@ func Example() {
 		fmt.Printf("failed to acquire resource: %v", err)
 	}
 }
+
+func Example_limiter() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed to connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	limiter, err := db.Ratelimiter().Limiter("/local/ratelimiter_test", "test_resource",
+		ratelimiter.WithPrefetch(100),
+	)
+	if err != nil {
+		fmt.Printf("failed to make limiter: %v", err)
+
+		return
+	}
+	defer limiter.Close(ctx)
+	// most of waits spend prefetched quota without request to server
+	for i := 0; i < 1000; i++ {
+		if err = limiter.Wait(ctx, 1); err != nil {
+			fmt.Printf("failed to wait quota: %v", err)
+
+			return
+		}
+	}
+}
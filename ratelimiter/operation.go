@@ -0,0 +1,28 @@
+package ratelimiter
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+// LimitOperation wraps query operation with waiting of amount units of limiter quota before each attempt
+// of operation. For example:
+//
+//	err := db.Query().Do(ctx, ratelimiter.LimitOperation(limiter, 1, func(ctx context.Context, s query.Session) error {
+//		...
+//	}))
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func LimitOperation(limiter Limiter, amount uint64, op query.Operation) query.Operation {
+	return func(ctx context.Context, s query.Session) error {
+		if err := limiter.Wait(ctx, amount); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return op(ctx, s)
+	}
+}
@@ -41,6 +41,37 @@ type Client interface {
 		amount uint64,
 		opts ...options.AcquireOption,
 	) (err error)
+
+	// Limiter makes client-side limiter over resource. Limiter prefetches quota from server in batches
+	// and spends it locally, so most acquires of limiter not make requests to server.
+	//
+	// # Experimental
+	//
+	// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+	Limiter(
+		coordinationNodePath string,
+		resourcePath string,
+		opts ...options.LimiterOption,
+	) (_ Limiter, err error)
+}
+
+// Limiter is client-side limiter with local quota, which prefetched from server, and optional
+// local token bucket (see WithRate)
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Limiter interface {
+	// Wait spends n units of quota, it waits for refill of local bucket and prefetch of quota from server
+	// until ctx done. Wait returns error if n exceeds burst of local bucket
+	Wait(ctx context.Context, n uint64) error
+
+	// Allow spends n units of quota if they are available locally. Allow never waits for server,
+	// but starts background prefetch if local quota is not enough
+	Allow(n uint64) bool
+
+	// Close stops prefetch of quota and reports unspent prefetched units to server (see WithReport)
+	Close(ctx context.Context) error
 }
 
 func WithAcquire() options.AcquireOption {
@@ -58,3 +89,21 @@ func WithOperationTimeout(operationTimeout time.Duration) options.AcquireOption
 func WithOperationCancelAfter(operationCancelAfter time.Duration) options.AcquireOption {
 	return options.WithOperationCancelAfter(operationCancelAfter)
 }
+
+// WithPrefetch defines count of units, which limiter acquires from server by one request
+func WithPrefetch(units uint64) options.LimiterOption {
+	return options.WithPrefetch(units)
+}
+
+// WithRate defines local token bucket of limiter: bucket refilled with rate units per second up to burst
+// units, limiter spends units only if bucket and prefetched quota have them.
+// Zero burst means burst equal to prefetch, zero rate means limiter without local rate limit
+func WithRate(rate float64, burst uint64) options.LimiterOption {
+	return options.WithRate(rate, burst)
+}
+
+// WithPrefetchWatermark defines part of prefetch units, limiter starts background prefetch
+// if local quota less than watermark. Watermark must be in range [0, 1]
+func WithPrefetchWatermark(watermark float64) options.LimiterOption {
+	return options.WithPrefetchWatermark(watermark)
+}
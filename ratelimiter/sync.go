@@ -0,0 +1,293 @@
+package ratelimiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var (
+	errDuplicateResource = xerrors.Wrap(errors.New("ydb: duplicate resource in desired resources"))
+	errMissingParent     = xerrors.Wrap(errors.New("ydb: parent of resource not exists"))
+	errOutOfSubtree      = xerrors.Wrap(errors.New("ydb: desired resource out of subtree"))
+)
+
+// ChangeType is type of resource change in sync plan
+type ChangeType int
+
+const (
+	ChangeCreate = ChangeType(iota)
+	ChangeAlter
+	ChangeDrop
+)
+
+func (t ChangeType) String() string {
+	switch t {
+	case ChangeCreate:
+		return "create"
+	case ChangeAlter:
+		return "alter"
+	case ChangeDrop:
+		return "drop"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(t))
+	}
+}
+
+// Change is change of one resource in sync plan
+type Change struct {
+	Type ChangeType
+
+	// Resource is desired state of resource, only ResourcePath is defined for ChangeDrop
+	Resource Resource
+}
+
+func (c Change) String() string {
+	if c.Type == ChangeDrop {
+		return fmt.Sprintf("%s %q", c.Type, c.Resource.ResourcePath)
+	}
+
+	return fmt.Sprintf("%s %q %+v", c.Type, c.Resource.ResourcePath, c.Resource.HierarchicalDrr)
+}
+
+// Plan is ordered list of changes, which makes resources of coordination node equal to desired resources.
+// Parents created before children, children dropped before parents.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Plan struct {
+	client               Client
+	coordinationNodePath string
+
+	Changes []Change
+}
+
+// Empty returns true if resources already equal to desired resources
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Apply applies changes of plan in order
+func (p *Plan) Apply(ctx context.Context) error {
+	for _, change := range p.Changes {
+		var err error
+		switch change.Type {
+		case ChangeCreate:
+			err = p.client.CreateResource(ctx, p.coordinationNodePath, change.Resource)
+		case ChangeAlter:
+			err = p.client.AlterResource(ctx, p.coordinationNodePath, change.Resource)
+		case ChangeDrop:
+			err = p.client.DropResource(ctx, p.coordinationNodePath, change.Resource.ResourcePath)
+		}
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("%s: %w", change, err))
+		}
+	}
+
+	return nil
+}
+
+func (p *Plan) String() string {
+	changes := make([]string, len(p.Changes))
+	for i, change := range p.Changes {
+		changes[i] = change.String()
+	}
+
+	return strings.Join(changes, "\n")
+}
+
+type syncOptions struct {
+	subtree string
+	noDrop  bool
+}
+
+// SyncOption is option for MakePlan and Sync
+type SyncOption func(o *syncOptions)
+
+// WithSubtree restricts sync by resources of subtree with root resourcePath.
+// Resources out of subtree are not dropped, desired resources out of subtree are rejected.
+// Subtree root may not exist yet, in this case it must be in desired resources.
+func WithSubtree(resourcePath string) SyncOption {
+	return func(o *syncOptions) {
+		o.subtree = normalizeResourcePath(resourcePath)
+	}
+}
+
+// WithoutDrop disables drop of resources, which are not in desired resources
+func WithoutDrop() SyncOption {
+	return func(o *syncOptions) {
+		o.noDrop = true
+	}
+}
+
+// MakePlan compares desired resources with resources of coordination node and returns plan of changes.
+// MakePlan not changes resources, so it can be used for dry-run of Sync.
+//
+// Zero fields of desired HierarchicalDrr are not compared, because server fills them with defaults
+// or values of parent resource.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func MakePlan(
+	ctx context.Context,
+	client Client,
+	coordinationNodePath string,
+	resources []Resource,
+	opts ...SyncOption,
+) (*Plan, error) {
+	options := syncOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+
+	desired := make(map[string]Resource, len(resources))
+	for _, resource := range resources {
+		resource.ResourcePath = normalizeResourcePath(resource.ResourcePath)
+		if _, has := desired[resource.ResourcePath]; has {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errDuplicateResource, resource.ResourcePath))
+		}
+		if !inSubtree(resource.ResourcePath, options.subtree) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errOutOfSubtree, resource.ResourcePath))
+		}
+		desired[resource.ResourcePath] = resource
+	}
+
+	paths, err := client.ListResource(ctx, coordinationNodePath, options.subtree, true)
+	if err != nil {
+		// not existing subtree root has no resources yet
+		if options.subtree == "" || !xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND) {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		paths = nil
+	}
+	existing := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		existing[normalizeResourcePath(path)] = struct{}{}
+	}
+
+	var creates, alters, drops []Change
+	for path, resource := range desired {
+		if _, has := existing[path]; !has {
+			creates = append(creates, Change{Type: ChangeCreate, Resource: resource})
+
+			continue
+		}
+		current, err := client.DescribeResource(ctx, coordinationNodePath, path)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if drrChanged(current.HierarchicalDrr, resource.HierarchicalDrr) {
+			alters = append(alters, Change{Type: ChangeAlter, Resource: resource})
+		}
+	}
+	if !options.noDrop {
+		for path := range existing {
+			if _, has := desired[path]; !has && inSubtree(path, options.subtree) {
+				drops = append(drops, Change{Type: ChangeDrop, Resource: Resource{ResourcePath: path}})
+			}
+		}
+	}
+
+	// parents must be created before children and dropped after children
+	sort.Slice(creates, func(i, j int) bool {
+		return lessByDepth(creates[i].Resource.ResourcePath, creates[j].Resource.ResourcePath)
+	})
+	sort.Slice(alters, func(i, j int) bool {
+		return alters[i].Resource.ResourcePath < alters[j].Resource.ResourcePath
+	})
+	sort.Slice(drops, func(i, j int) bool {
+		return lessByDepth(drops[j].Resource.ResourcePath, drops[i].Resource.ResourcePath)
+	})
+
+	for _, change := range creates {
+		parent := parentResourcePath(change.Resource.ResourcePath)
+		// parent of subtree root is out of listing, server checks it on create
+		if parent == "" || !inSubtree(parent, options.subtree) {
+			continue
+		}
+		if _, has := desired[parent]; has {
+			continue
+		}
+		if _, has := existing[parent]; !has || (!options.noDrop && inSubtree(parent, options.subtree)) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errMissingParent, change.Resource.ResourcePath))
+		}
+	}
+
+	return &Plan{
+		client:               client,
+		coordinationNodePath: coordinationNodePath,
+		Changes:              append(append(creates, alters...), drops...),
+	}, nil
+}
+
+// Sync makes resources of coordination node equal to desired resources and returns applied plan
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func Sync(
+	ctx context.Context,
+	client Client,
+	coordinationNodePath string,
+	resources []Resource,
+	opts ...SyncOption,
+) (*Plan, error) {
+	plan, err := MakePlan(ctx, client, coordinationNodePath, resources, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if err = plan.Apply(ctx); err != nil {
+		return plan, xerrors.WithStackTrace(err)
+	}
+
+	return plan, nil
+}
+
+func normalizeResourcePath(path string) string {
+	return strings.Trim(path, "/")
+}
+
+func parentResourcePath(path string) string {
+	if i := strings.LastIndexByte(path, '/'); i >= 0 {
+		return path[:i]
+	}
+
+	return ""
+}
+
+func inSubtree(path, subtree string) bool {
+	return subtree == "" || path == subtree || strings.HasPrefix(path, subtree+"/")
+}
+
+func lessByDepth(lhs, rhs string) bool {
+	lhsDepth, rhsDepth := strings.Count(lhs, "/"), strings.Count(rhs, "/")
+	if lhsDepth != rhsDepth {
+		return lhsDepth < rhsDepth
+	}
+
+	return lhs < rhs
+}
+
+// drrChanged returns true if fields, which set in desired settings, differ from current settings.
+// Zero fields of desired settings are filled by server, so they are not compared
+func drrChanged(current, desired HierarchicalDrrSettings) bool {
+	changed := func(current, desired float64) bool {
+		return desired != 0 && desired != current
+	}
+
+	return changed(current.MaxUnitsPerSecond, desired.MaxUnitsPerSecond) ||
+		changed(current.MaxBurstSizeCoefficient, desired.MaxBurstSizeCoefficient) ||
+		changed(current.PrefetchCoefficient, desired.PrefetchCoefficient) ||
+		changed(current.PrefetchWatermark, desired.PrefetchWatermark)
+}
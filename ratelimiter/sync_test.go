@@ -0,0 +1,279 @@
+package ratelimiter
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+type testClient struct {
+	Client
+
+	resources map[string]Resource
+	calls     []string
+}
+
+func (c *testClient) CreateResource(ctx context.Context, _ string, resource Resource) error {
+	c.calls = append(c.calls, "create "+resource.ResourcePath)
+	c.resources[resource.ResourcePath] = resource
+
+	return nil
+}
+
+func (c *testClient) AlterResource(ctx context.Context, _ string, resource Resource) error {
+	c.calls = append(c.calls, "alter "+resource.ResourcePath)
+	c.resources[resource.ResourcePath] = resource
+
+	return nil
+}
+
+func (c *testClient) DropResource(ctx context.Context, _ string, resourcePath string) error {
+	c.calls = append(c.calls, "drop "+resourcePath)
+	delete(c.resources, resourcePath)
+
+	return nil
+}
+
+func (c *testClient) ListResource(ctx context.Context, _ string, resourcePath string, _ bool) ([]string, error) {
+	if _, has := c.resources[resourcePath]; resourcePath != "" && !has {
+		return nil, xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_NOT_FOUND))
+	}
+	var paths []string
+	for path := range c.resources {
+		if resourcePath == "" || path == resourcePath || strings.HasPrefix(path, resourcePath+"/") {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+func (c *testClient) DescribeResource(ctx context.Context, _ string, resourcePath string) (*Resource, error) {
+	resource := c.resources[resourcePath]
+
+	return &resource, nil
+}
+
+func drr(maxUnitsPerSecond float64) HierarchicalDrrSettings {
+	return HierarchicalDrrSettings{MaxUnitsPerSecond: maxUnitsPerSecond}
+}
+
+func TestSync(t *testing.T) {
+	ctx := context.Background()
+	client := &testClient{
+		resources: map[string]Resource{
+			"root":          {ResourcePath: "root", HierarchicalDrr: drr(100)},
+			"root/a":        {ResourcePath: "root/a", HierarchicalDrr: drr(10)},
+			"root/b":        {ResourcePath: "root/b", HierarchicalDrr: drr(10)},
+			"root/b/b1":     {ResourcePath: "root/b/b1", HierarchicalDrr: drr(1)},
+			"other":         {ResourcePath: "other", HierarchicalDrr: drr(1)},
+			"other/child":   {ResourcePath: "other/child", HierarchicalDrr: drr(1)},
+			"root/a/a1":     {ResourcePath: "root/a/a1", HierarchicalDrr: drr(5)},
+			"root/a/a1/a11": {ResourcePath: "root/a/a1/a11", HierarchicalDrr: drr(5)},
+		},
+	}
+	desired := []Resource{
+		{ResourcePath: "/root", HierarchicalDrr: drr(100)},
+		{ResourcePath: "root/a", HierarchicalDrr: drr(20)},
+		{ResourcePath: "root/c", HierarchicalDrr: drr(10)},
+		{ResourcePath: "root/c/c1", HierarchicalDrr: drr(1)},
+		{ResourcePath: "root/a/a1", HierarchicalDrr: drr(5)},
+	}
+
+	plan, err := MakePlan(ctx, client, "/local/node", desired, WithSubtree("root"))
+	require.NoError(t, err)
+	require.Equal(t, []Change{
+		{Type: ChangeCreate, Resource: Resource{ResourcePath: "root/c", HierarchicalDrr: drr(10)}},
+		{Type: ChangeCreate, Resource: Resource{ResourcePath: "root/c/c1", HierarchicalDrr: drr(1)}},
+		{Type: ChangeAlter, Resource: Resource{ResourcePath: "root/a", HierarchicalDrr: drr(20)}},
+		{Type: ChangeDrop, Resource: Resource{ResourcePath: "root/a/a1/a11"}},
+		{Type: ChangeDrop, Resource: Resource{ResourcePath: "root/b/b1"}},
+		{Type: ChangeDrop, Resource: Resource{ResourcePath: "root/b"}},
+	}, plan.Changes)
+	// dry-run not changes resources
+	require.Empty(t, client.calls)
+
+	plan, err = Sync(ctx, client, "/local/node", desired, WithSubtree("root"))
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 6)
+	require.Equal(t, []string{
+		"create root/c",
+		"create root/c/c1",
+		"alter root/a",
+		"drop root/a/a1/a11",
+		"drop root/b/b1",
+		"drop root/b",
+	}, client.calls)
+	require.Contains(t, client.resources, "other/child")
+
+	plan, err = MakePlan(ctx, client, "/local/node", desired, WithSubtree("root"))
+	require.NoError(t, err)
+	require.True(t, plan.Empty())
+}
+
+func TestMakePlanServerDefaults(t *testing.T) {
+	ctx := context.Background()
+	client := &testClient{
+		resources: map[string]Resource{
+			"root": {ResourcePath: "root", HierarchicalDrr: HierarchicalDrrSettings{
+				MaxUnitsPerSecond:       100,
+				MaxBurstSizeCoefficient: 1,
+				PrefetchCoefficient:     0.2,
+				PrefetchWatermark:       0.75,
+			}},
+		},
+	}
+
+	// fields filled by server with defaults not makes changes
+	plan, err := MakePlan(ctx, client, "/local/node", []Resource{
+		{ResourcePath: "root", HierarchicalDrr: drr(100)},
+	})
+	require.NoError(t, err)
+	require.True(t, plan.Empty())
+
+	plan, err = MakePlan(ctx, client, "/local/node", []Resource{
+		{ResourcePath: "root", HierarchicalDrr: HierarchicalDrrSettings{MaxUnitsPerSecond: 100, PrefetchWatermark: 0.5}},
+	})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1)
+	require.Equal(t, ChangeAlter, plan.Changes[0].Type)
+}
+
+func TestMakePlanErrors(t *testing.T) {
+	ctx := context.Background()
+	client := &testClient{
+		resources: map[string]Resource{
+			"root": {ResourcePath: "root", HierarchicalDrr: drr(100)},
+		},
+	}
+
+	_, err := MakePlan(ctx, client, "/local/node", []Resource{
+		{ResourcePath: "root/a"},
+		{ResourcePath: "/root/a/"},
+	}, WithoutDrop())
+	require.ErrorIs(t, err, errDuplicateResource)
+
+	_, err = MakePlan(ctx, client, "/local/node", []Resource{
+		{ResourcePath: "root/a/b"},
+	}, WithoutDrop())
+	require.ErrorIs(t, err, errMissingParent)
+
+	// parent dropped by plan
+	_, err = MakePlan(ctx, client, "/local/node", []Resource{
+		{ResourcePath: "root/a"},
+	})
+	require.ErrorIs(t, err, errMissingParent)
+
+	plan, err := MakePlan(ctx, client, "/local/node", []Resource{
+		{ResourcePath: "root/a"},
+	}, WithoutDrop())
+	require.NoError(t, err)
+	require.Equal(t, `create "root/a" {MaxUnitsPerSecond:0 MaxBurstSizeCoefficient:0 PrefetchCoefficient:0 `+
+		`PrefetchWatermark:0}`, plan.String())
+}
+
+func TestMakePlanSubtree(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("DesiredOutOfSubtree", func(t *testing.T) {
+		client := &testClient{
+			resources: map[string]Resource{
+				"root":  {ResourcePath: "root", HierarchicalDrr: drr(100)},
+				"other": {ResourcePath: "other", HierarchicalDrr: drr(1)},
+			},
+		}
+		_, err := MakePlan(ctx, client, "/local/node", []Resource{
+			{ResourcePath: "root", HierarchicalDrr: drr(100)},
+			{ResourcePath: "other/child", HierarchicalDrr: drr(1)},
+		}, WithSubtree("root"))
+		require.ErrorIs(t, err, errOutOfSubtree)
+
+		// subtree root is not prefix of sibling resource
+		_, err = MakePlan(ctx, client, "/local/node", []Resource{
+			{ResourcePath: "root2", HierarchicalDrr: drr(1)},
+		}, WithSubtree("root"))
+		require.ErrorIs(t, err, errOutOfSubtree)
+	})
+
+	t.Run("CreateSubtreeRoot", func(t *testing.T) {
+		client := &testClient{
+			resources: map[string]Resource{
+				"other": {ResourcePath: "other", HierarchicalDrr: drr(1)},
+			},
+		}
+		desired := []Resource{
+			{ResourcePath: "root", HierarchicalDrr: drr(100)},
+			{ResourcePath: "root/a", HierarchicalDrr: drr(10)},
+		}
+		plan, err := Sync(ctx, client, "/local/node", desired, WithSubtree("root"))
+		require.NoError(t, err)
+		require.Equal(t, []Change{
+			{Type: ChangeCreate, Resource: Resource{ResourcePath: "root", HierarchicalDrr: drr(100)}},
+			{Type: ChangeCreate, Resource: Resource{ResourcePath: "root/a", HierarchicalDrr: drr(10)}},
+		}, plan.Changes)
+		require.Equal(t, []string{"create root", "create root/a"}, client.calls)
+	})
+
+	t.Run("CreateNestedSubtreeRoot", func(t *testing.T) {
+		client := &testClient{
+			resources: map[string]Resource{
+				"root": {ResourcePath: "root", HierarchicalDrr: drr(100)},
+			},
+		}
+		plan, err := MakePlan(ctx, client, "/local/node", []Resource{
+			{ResourcePath: "root/a", HierarchicalDrr: drr(10)},
+		}, WithSubtree("root/a"))
+		require.NoError(t, err)
+		require.Equal(t, []Change{
+			{Type: ChangeCreate, Resource: Resource{ResourcePath: "root/a", HierarchicalDrr: drr(10)}},
+		}, plan.Changes)
+	})
+
+	t.Run("SubtreeRootNotFound", func(t *testing.T) {
+		client := &testClient{
+			resources: map[string]Resource{},
+		}
+		plan, err := MakePlan(ctx, client, "/local/node", nil, WithSubtree("root"))
+		require.NoError(t, err)
+		require.True(t, plan.Empty())
+	})
+}
+
+type testLimiter struct {
+	Limiter
+
+	waits []uint64
+}
+
+func (l *testLimiter) Wait(ctx context.Context, n uint64) error {
+	l.waits = append(l.waits, n)
+
+	return ctx.Err()
+}
+
+func TestLimitOperation(t *testing.T) {
+	limiter := &testLimiter{}
+	calls := 0
+	op := LimitOperation(limiter, 3, func(ctx context.Context, s query.Session) error {
+		calls++
+
+		return nil
+	})
+
+	require.NoError(t, op(context.Background(), nil))
+	require.Equal(t, []uint64{3}, limiter.waits)
+	require.Equal(t, 1, calls)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, op(ctx, nil), context.Canceled)
+	require.Equal(t, 1, calls)
+}
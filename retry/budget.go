@@ -0,0 +1,198 @@
+package retry
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	defaultBudgetRatio     = 0.1
+	defaultBudgetMinTokens = 10
+	defaultBudgetWindow    = 10 * time.Second
+
+	budgetBuckets = 10
+)
+
+// ErrBudgetExhausted is returned from Retry if retry is rejected because retry budget is exhausted
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+var ErrBudgetExhausted = xerrors.Wrap(errors.New("ydb: retry budget exhausted"))
+
+// Budget is a shared token bucket of retries, which prevents retry storms on partial outage.
+// Each retry requires one token, each successful call adds ratio of token. Capacity of bucket
+// is a ratio of count of calls in window, but not less than min tokens.
+// Budget must be created once and shared between calls
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Budget struct {
+	ratio     float64
+	minTokens float64
+	window    time.Duration
+	now       func() time.Time
+
+	mu      sync.Mutex
+	tokens  float64
+	buckets [budgetBuckets]budgetBucket
+}
+
+type budgetBucket struct {
+	id    int64
+	calls int
+}
+
+// BudgetOption is an option for NewBudget
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type BudgetOption func(b *Budget)
+
+// WithBudgetRatio defines ratio of retries to calls. Default ratio is 0.1
+func WithBudgetRatio(ratio float64) BudgetOption {
+	return func(b *Budget) {
+		b.ratio = math.Max(ratio, 0)
+	}
+}
+
+// WithBudgetMinTokens defines min capacity of budget for low rate of calls. Default min tokens is 10
+func WithBudgetMinTokens(minTokens int) BudgetOption {
+	return func(b *Budget) {
+		b.minTokens = math.Max(float64(minTokens), 0)
+	}
+}
+
+// WithBudgetWindow defines window for rate of calls. Default window is 10s
+func WithBudgetWindow(window time.Duration) BudgetOption {
+	return func(b *Budget) {
+		if window > 0 {
+			b.window = window
+		}
+	}
+}
+
+// NewBudget makes retry budget for WithBudget option
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func NewBudget(opts ...BudgetOption) *Budget {
+	b := &Budget{
+		ratio:     defaultBudgetRatio,
+		minTokens: defaultBudgetMinTokens,
+		window:    defaultBudgetWindow,
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(b)
+		}
+	}
+	b.tokens = b.minTokens
+
+	return b
+}
+
+// Stats returns current count of tokens and capacity of budget
+func (b *Budget) Stats() (tokens, capacity float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	capacity = b.capacity()
+	b.tokens = math.Min(b.tokens, capacity)
+
+	return b.tokens, capacity
+}
+
+// capacity must be called under lock
+func (b *Budget) capacity() float64 {
+	id := b.bucketID()
+	calls := 0
+	for i := range b.buckets {
+		if b.buckets[i].id > id-budgetBuckets {
+			calls += b.buckets[i].calls
+		}
+	}
+
+	return math.Max(b.minTokens, b.ratio*float64(calls))
+}
+
+func (b *Budget) bucketID() int64 {
+	width := int64(b.window / budgetBuckets)
+	if width <= 0 {
+		width = 1
+	}
+
+	return b.now().UnixNano() / width
+}
+
+// call registers call for rate of calls
+func (b *Budget) call() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.bucketID()
+	bucket := &b.buckets[id%budgetBuckets]
+	if bucket.id != id {
+		*bucket = budgetBucket{id: id}
+	}
+	bucket.calls++
+}
+
+// success refills budget by successful call
+func (b *Budget) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = math.Min(b.tokens+b.ratio, b.capacity())
+}
+
+// withdraw takes token for retry
+func (b *Budget) withdraw() (allowed bool, tokens, capacity float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	capacity = b.capacity()
+	b.tokens = math.Min(b.tokens, capacity)
+	if b.tokens < 1 {
+		return false, b.tokens, capacity
+	}
+	b.tokens--
+
+	return true, b.tokens, capacity
+}
+
+var _ Option = budgetOption{}
+
+type budgetOption struct {
+	b *Budget
+}
+
+func (o budgetOption) ApplyRetryOption(opts *retryOptions) {
+	opts.budget = o.b
+}
+
+func (o budgetOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, o)
+}
+
+func (o budgetOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, o)
+}
+
+// WithBudget defines shared retry budget, which is consulted before each retry. If budget is exhausted
+// Retry returns error with ErrBudgetExhausted. Nil budget disables budget of retries
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithBudget(b *Budget) budgetOption {
+	return budgetOption{b: b}
+}
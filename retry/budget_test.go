@@ -0,0 +1,85 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type testBudgetClock struct {
+	now time.Time
+}
+
+func (c *testBudgetClock) Now() time.Time {
+	return c.now
+}
+
+func TestBudget(t *testing.T) {
+	clock := &testBudgetClock{now: time.Unix(0, 0)}
+	b := NewBudget(WithBudgetRatio(0.5), WithBudgetMinTokens(2), WithBudgetWindow(time.Second))
+	b.now = clock.Now
+
+	tokens, capacity := b.Stats()
+	require.Equal(t, 2.0, tokens)
+	require.Equal(t, 2.0, capacity)
+
+	allowed, _, _ := b.withdraw()
+	require.True(t, allowed)
+	allowed, _, _ = b.withdraw()
+	require.True(t, allowed)
+	allowed, _, _ = b.withdraw()
+	require.False(t, allowed)
+
+	// successful calls refill budget, capacity grows with rate of calls
+	for i := 0; i < 10; i++ {
+		b.call()
+		b.success()
+	}
+	tokens, capacity = b.Stats()
+	require.Equal(t, 5.0, tokens)
+	require.Equal(t, 5.0, capacity)
+
+	// calls out of window are forgotten, so tokens are limited by min capacity
+	clock.now = clock.now.Add(2 * time.Second)
+	tokens, capacity = b.Stats()
+	require.Equal(t, 2.0, tokens)
+	require.Equal(t, 2.0, capacity)
+}
+
+func TestRetryWithBudget(t *testing.T) {
+	var (
+		b        = NewBudget(WithBudgetRatio(0), WithBudgetMinTokens(2))
+		attempts = 0
+		budget   []bool
+	)
+	err := Retry(context.Background(), func(ctx context.Context) error {
+		attempts++
+
+		return RetryableError(errors.New("test"), WithBackoff(backoff.TypeNoBackoff))
+	},
+		WithBudget(b),
+		WithTrace(&trace.Retry{
+			OnBudget: func(info trace.RetryBudgetInfo) {
+				budget = append(budget, info.Allowed)
+			},
+		}),
+	)
+	require.ErrorIs(t, err, ErrBudgetExhausted)
+	require.Equal(t, 3, attempts)
+	require.Equal(t, []bool{true, true, false}, budget)
+
+	// first attempt not requires budget
+	attempts = 0
+	require.NoError(t, Retry(context.Background(), func(ctx context.Context) error {
+		attempts++
+
+		return nil
+	}, WithBudget(b)))
+	require.Equal(t, 1, attempts)
+}
@@ -0,0 +1,278 @@
+package retry
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const (
+	defaultHedgingPercentile = 0.95
+	defaultHedgingMinDelay   = 5 * time.Millisecond
+	defaultHedgingMaxDelay   = time.Second
+	defaultHedgingRatio      = 0.1
+	defaultHedgingBurst      = 10
+
+	// hedgingWindow is a count of last latencies for percentile of latency
+	hedgingWindow = 128
+	// hedgingMinSamples is a count of latencies, which are required for adaptive delay,
+	// before this max delay is used
+	hedgingMinSamples = 16
+)
+
+// Hedging is a shared state of hedged requests: adaptive hedge delay by percentile of latencies
+// of attempts and global budget of hedged requests. Hedging must be created once and shared
+// between calls
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Hedging struct {
+	percentile float64
+	minDelay   time.Duration
+	maxDelay   time.Duration
+	ratio      float64
+	burst      float64
+
+	mu        sync.Mutex
+	latencies [hedgingWindow]time.Duration
+	samples   int
+	delay     time.Duration
+	tokens    float64
+}
+
+// HedgingOption is an option for NewHedging
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type HedgingOption func(h *Hedging)
+
+// WithHedgingPercentile defines percentile of latencies of attempts for hedge delay. Default percentile is 0.95
+func WithHedgingPercentile(percentile float64) HedgingOption {
+	return func(h *Hedging) {
+		h.percentile = math.Min(math.Max(percentile, 0), 1)
+	}
+}
+
+// WithHedgingDelay defines limits of adaptive hedge delay. Default limits are 5ms and 1s.
+// Max delay is used while latencies of attempts are not collected
+func WithHedgingDelay(minDelay, maxDelay time.Duration) HedgingOption {
+	return func(h *Hedging) {
+		h.minDelay = minDelay
+		h.maxDelay = maxDelay
+	}
+}
+
+// WithHedgingBudget defines budget of hedged requests: each hedged request requires one token,
+// each call adds ratio of token up to burst tokens. Default ratio is 0.1 (hedged requests
+// are not more than 10% of calls) and default burst is 10
+func WithHedgingBudget(ratio float64, burst int) HedgingOption {
+	return func(h *Hedging) {
+		h.ratio = math.Max(ratio, 0)
+		h.burst = math.Max(float64(burst), 1)
+	}
+}
+
+// NewHedging makes state of hedged requests for WithHedging option
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func NewHedging(opts ...HedgingOption) *Hedging {
+	h := &Hedging{
+		percentile: defaultHedgingPercentile,
+		minDelay:   defaultHedgingMinDelay,
+		maxDelay:   defaultHedgingMaxDelay,
+		ratio:      defaultHedgingRatio,
+		burst:      defaultHedgingBurst,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(h)
+		}
+	}
+	if h.maxDelay < h.minDelay {
+		h.maxDelay = h.minDelay
+	}
+	h.delay = h.maxDelay
+	h.tokens = h.burst
+
+	return h
+}
+
+// Delay returns current adaptive hedge delay
+func (h *Hedging) Delay() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.delay
+}
+
+// observe adds latency of successful attempt and recalculates hedge delay
+func (h *Hedging) observe(latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.latencies[h.samples%hedgingWindow] = latency
+	h.samples++
+	if h.samples < hedgingMinSamples {
+		return
+	}
+
+	n := h.samples
+	if n > hedgingWindow {
+		n = hedgingWindow
+	}
+	latencies := make([]time.Duration, n)
+	copy(latencies, h.latencies[:n])
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+
+	i := int(math.Ceil(h.percentile*float64(n))) - 1
+	if i < 0 {
+		i = 0
+	}
+	delay := latencies[i]
+	if delay < h.minDelay {
+		delay = h.minDelay
+	}
+	if delay > h.maxDelay {
+		delay = h.maxDelay
+	}
+	h.delay = delay
+}
+
+// deposit adds ratio of token to budget on each call
+func (h *Hedging) deposit() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.tokens = math.Min(h.tokens+h.ratio, h.burst)
+}
+
+// withdraw takes token for hedged request from budget
+func (h *Hedging) withdraw() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.tokens < 1 {
+		return false
+	}
+	h.tokens--
+
+	return true
+}
+
+type hedgeResult struct {
+	err   error
+	hedge bool
+}
+
+// do makes attempt and sends hedged request if attempt not finished on hedge delay.
+// Result of first successful request is taken, other request is cancelled and do waits
+// for exit of it. Observed latency is measured from start of attempt, so includes hedge delay
+// if hedged request won
+func (h *Hedging) do(ctx context.Context, op retryOperation, options *retryOptions) error {
+	h.deposit()
+
+	var (
+		attemptStart = time.Now()
+		results      = make(chan hedgeResult, 2)
+		cancels      = make([]context.CancelFunc, 0, 2)
+		wg           sync.WaitGroup
+		start        = func(hedge bool) {
+			ctx, cancel := xcontext.WithCancel(ctx)
+			cancels = append(cancels, cancel)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results <- hedgeResult{err: op(ctx), hedge: hedge}
+			}()
+		}
+		delay = h.Delay()
+		timer = time.NewTimer(delay)
+	)
+	defer func() {
+		timer.Stop()
+		// cancel of loser request
+		for _, cancel := range cancels {
+			cancel()
+		}
+		wg.Wait()
+	}()
+
+	start(false)
+
+	var (
+		inflight = 1
+		onDone   func(hedgeWon bool, _ error)
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			if onDone != nil {
+				onDone(false, ctx.Err())
+			}
+
+			return ctx.Err()
+		case <-timer.C:
+			allowed := h.withdraw()
+			onDone = trace.RetryOnHedge(options.trace, &ctx, options.call, options.label, delay, allowed)
+			if allowed {
+				inflight++
+				start(true)
+			}
+		case result := <-results:
+			inflight--
+			if result.err == nil || inflight == 0 {
+				if result.err == nil {
+					h.observe(time.Since(attemptStart))
+				}
+				if onDone != nil {
+					onDone(result.hedge, result.err)
+				}
+
+				return result.err
+			}
+		}
+	}
+}
+
+var _ Option = hedgingOption{}
+
+type hedgingOption struct {
+	h *Hedging
+}
+
+func (o hedgingOption) ApplyRetryOption(opts *retryOptions) {
+	opts.hedging = o.h
+}
+
+func (o hedgingOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, o)
+}
+
+func (o hedgingOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, o)
+}
+
+// WithHedging enables hedged requests for idempotent operations with read-only transaction control:
+// if attempt not finished on adaptive hedge delay, duplicate request is sent (with other session,
+// so usually to other node) and result of first successful request is taken.
+// Read-only transaction control is detected by DoTx of table, query and database/sql clients.
+// Operation is called concurrently by attempt and hedged request, so operation must be safe for
+// concurrent calls (not share mutable state without synchronization)
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithHedging(h *Hedging) hedgingOption {
+	return hedgingOption{h: h}
+}
@@ -0,0 +1,142 @@
+package retry
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestHedgingDelay(t *testing.T) {
+	h := NewHedging(
+		WithHedgingPercentile(0.9),
+		WithHedgingDelay(2*time.Millisecond, 50*time.Millisecond),
+	)
+	require.Equal(t, 50*time.Millisecond, h.Delay())
+	for i := 1; i <= 20; i++ {
+		h.observe(time.Duration(i) * time.Millisecond)
+	}
+	require.Equal(t, 18*time.Millisecond, h.Delay())
+
+	// delay is limited by min delay
+	for i := 0; i < hedgingWindow; i++ {
+		h.observe(time.Microsecond)
+	}
+	require.Equal(t, 2*time.Millisecond, h.Delay())
+}
+
+func TestHedgingBudget(t *testing.T) {
+	h := NewHedging(WithHedgingBudget(0.5, 2))
+	require.True(t, h.withdraw())
+	require.True(t, h.withdraw())
+	require.False(t, h.withdraw())
+	h.deposit()
+	require.False(t, h.withdraw())
+	h.deposit()
+	require.True(t, h.withdraw())
+	// budget is limited by burst
+	for i := 0; i < 10; i++ {
+		h.deposit()
+	}
+	require.True(t, h.withdraw())
+	require.True(t, h.withdraw())
+	require.False(t, h.withdraw())
+}
+
+func TestRetryWithHedging(t *testing.T) {
+	var (
+		ctx = xcontext.WithReadOnly(context.Background())
+		h   = NewHedging(WithHedgingDelay(time.Millisecond, time.Millisecond))
+	)
+	slowFirst := func(calls *int64, loserCancelled chan struct{}) retryOperation {
+		return func(ctx context.Context) error {
+			if atomic.AddInt64(calls, 1) == 1 {
+				<-ctx.Done()
+				close(loserCancelled)
+
+				return ctx.Err()
+			}
+
+			return nil
+		}
+	}
+	t.Run("HedgeWon", func(t *testing.T) {
+		var (
+			calls          int64
+			loserCancelled = make(chan struct{})
+			hedges         []trace.RetryHedgeDoneInfo
+			h              = NewHedging(WithHedgingDelay(time.Millisecond, time.Millisecond))
+		)
+		err := Retry(ctx, slowFirst(&calls, loserCancelled),
+			WithIdempotent(true),
+			WithHedging(h),
+			WithTrace(&trace.Retry{
+				OnHedge: func(info trace.RetryHedgeStartInfo) func(trace.RetryHedgeDoneInfo) {
+					require.True(t, info.Allowed)
+					require.Equal(t, time.Millisecond, info.Delay)
+
+					return func(info trace.RetryHedgeDoneInfo) {
+						hedges = append(hedges, info)
+					}
+				},
+			}),
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, atomic.LoadInt64(&calls))
+		require.Equal(t, []trace.RetryHedgeDoneInfo{{HedgeWon: true}}, hedges)
+		// loser request is finished before return
+		select {
+		case <-loserCancelled:
+		default:
+			t.Fatal("loser request is not finished")
+		}
+		// latency of attempt includes hedge delay
+		require.Equal(t, 1, h.samples)
+		require.GreaterOrEqual(t, h.latencies[0], time.Millisecond)
+	})
+	t.Run("NonIdempotent", func(t *testing.T) {
+		var calls int64
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		err := Retry(ctx, slowFirst(&calls, make(chan struct{})), WithHedging(h))
+		require.Error(t, err)
+		require.EqualValues(t, 1, atomic.LoadInt64(&calls))
+	})
+	t.Run("NotReadOnly", func(t *testing.T) {
+		var calls int64
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		err := Retry(ctx, slowFirst(&calls, make(chan struct{})), WithIdempotent(true), WithHedging(h))
+		require.Error(t, err)
+		require.EqualValues(t, 1, atomic.LoadInt64(&calls))
+	})
+	t.Run("BudgetExhausted", func(t *testing.T) {
+		var (
+			calls   int64
+			allowed []bool
+		)
+		h := NewHedging(WithHedgingDelay(time.Millisecond, time.Millisecond), WithHedgingBudget(0, 1))
+		require.True(t, h.withdraw())
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		err := Retry(ctx, slowFirst(&calls, make(chan struct{})),
+			WithIdempotent(true),
+			WithHedging(h),
+			WithTrace(&trace.Retry{
+				OnHedge: func(info trace.RetryHedgeStartInfo) func(trace.RetryHedgeDoneInfo) {
+					allowed = append(allowed, info.Allowed)
+
+					return nil
+				},
+			}),
+		)
+		require.Error(t, err)
+		require.EqualValues(t, 1, atomic.LoadInt64(&calls))
+		require.Equal(t, []bool{false}, allowed)
+	})
+}
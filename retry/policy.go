@@ -0,0 +1,249 @@
+package retry
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Policy decides about retry of failed attempt of retry loop
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Policy interface {
+	Decide(attempt Attempt) Decision
+}
+
+// Attempt describes failed attempt of retry loop
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Attempt struct {
+	Label      string
+	Idempotent bool
+
+	// Attempts is a count of attempts including failed attempt.
+	// Attempts is zero on decision about deletion of session
+	Attempts int
+	// Elapsed is a time since start of retry loop.
+	// Elapsed is zero on decision about deletion of session
+	Elapsed time.Duration
+
+	Error error
+}
+
+// Decision is a decision of Policy about failed attempt
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Decision struct {
+	Retry bool
+
+	// BackoffType is a class of backoff before next attempt: backoff.TypeNoBackoff, backoff.TypeFast or backoff.TypeSlow
+	BackoffType backoff.Type
+	// Backoff replaces fast or slow backoff of retry loop if not nil
+	Backoff backoff.Backoff
+
+	// DeleteSession defines deletion of session of table or query client after failed attempt
+	DeleteSession bool
+}
+
+// RetryType defines retry of errors in Rule
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type RetryType int
+
+const (
+	// TypeNonRetryable errors are not retried
+	TypeNonRetryable = RetryType(iota)
+	// TypeConditionallyRetryable errors are retried only for idempotent operations
+	TypeConditionallyRetryable
+	// TypeRetryable errors are retried always
+	TypeRetryable
+)
+
+func (t RetryType) mustRetry(idempotent bool) bool {
+	switch t {
+	case TypeRetryable:
+		return true
+	case TypeConditionallyRetryable:
+		return idempotent
+	default:
+		return false
+	}
+}
+
+// Rule overrides decision of default policy for errors with some status or transport code
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Rule struct {
+	Type          RetryType
+	BackoffType   backoff.Type
+	DeleteSession bool
+}
+
+// Limits limits retry loop. Zero values are not limits
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type Limits struct {
+	MaxAttempts int
+	MaxElapsed  time.Duration
+}
+
+// PolicyOption is an option for NewPolicy
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type PolicyOption func(p *policy)
+
+// WithStatusRule overrides decision for operation errors with status code,
+// for example Ydb.StatusIds_OVERLOADED, Ydb.StatusIds_UNAVAILABLE or Ydb.StatusIds_SESSION_BUSY
+func WithStatusRule(code Ydb.StatusIds_StatusCode, rule Rule) PolicyOption {
+	return func(p *policy) {
+		p.statuses[code] = rule
+	}
+}
+
+// WithTransportRule overrides decision for transport errors with grpc code
+func WithTransportRule(code grpcCodes.Code, rule Rule) PolicyOption {
+	return func(p *policy) {
+		p.transport[code] = rule
+	}
+}
+
+// WithLimits defines limits of retry loops with label. Limits with empty label are applied
+// to retry loops without own limits
+func WithLimits(label string, limits Limits) PolicyOption {
+	return func(p *policy) {
+		p.limits[label] = limits
+	}
+}
+
+// WithClassBackoff replaces backoff of retry loop for class of backoff: backoff.TypeFast or backoff.TypeSlow
+func WithClassBackoff(t backoff.Type, b backoff.Backoff) PolicyOption {
+	return func(p *policy) {
+		p.backoffs[t] = b
+	}
+}
+
+// defaultPolicy makes same decisions as Check
+var defaultPolicy = NewPolicy()
+
+type policy struct {
+	statuses  map[Ydb.StatusIds_StatusCode]Rule
+	transport map[grpcCodes.Code]Rule
+	limits    map[string]Limits
+	backoffs  map[backoff.Type]backoff.Backoff
+}
+
+// NewPolicy makes table-driven retry policy. Without options policy makes same decisions as Check
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func NewPolicy(opts ...PolicyOption) Policy {
+	p := &policy{
+		statuses:  make(map[Ydb.StatusIds_StatusCode]Rule),
+		transport: make(map[grpcCodes.Code]Rule),
+		limits:    make(map[string]Limits),
+		backoffs:  make(map[backoff.Type]backoff.Backoff),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(p)
+		}
+	}
+
+	return p
+}
+
+func (p *policy) rule(err error) (Rule, bool) {
+	for code, rule := range p.statuses {
+		if xerrors.IsOperationError(err, code) {
+			return rule, true
+		}
+	}
+	for code, rule := range p.transport {
+		if xerrors.IsTransportError(err, code) {
+			return rule, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+func (p *policy) Decide(attempt Attempt) Decision {
+	var d Decision
+	if rule, has := p.rule(attempt.Error); has {
+		d = Decision{
+			Retry:         rule.Type.mustRetry(attempt.Idempotent),
+			BackoffType:   rule.BackoffType,
+			DeleteSession: rule.DeleteSession,
+		}
+	} else {
+		m := Check(attempt.Error)
+		d = Decision{
+			Retry:         m.MustRetry(attempt.Idempotent),
+			BackoffType:   m.BackoffType(),
+			DeleteSession: m.MustDeleteSession(),
+		}
+	}
+
+	limits, has := p.limits[attempt.Label]
+	if !has {
+		limits = p.limits[""]
+	}
+	if limits.MaxAttempts > 0 && attempt.Attempts >= limits.MaxAttempts {
+		d.Retry = false
+	}
+	if limits.MaxElapsed > 0 && attempt.Elapsed >= limits.MaxElapsed {
+		d.Retry = false
+	}
+
+	d.Backoff = p.backoffs[d.BackoffType]
+
+	return d
+}
+
+var _ Option = policyOption{}
+
+type policyOption struct {
+	p Policy
+}
+
+func (o policyOption) ApplyRetryOption(opts *retryOptions) {
+	if o.p != nil {
+		opts.policy = o.p
+	}
+}
+
+func (o policyOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, o)
+}
+
+func (o policyOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, o)
+}
+
+// WithPolicy replaces default retry policy. Nil policy is ignored
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithPolicy(p Policy) policyOption {
+	return policyOption{p: p}
+}
@@ -0,0 +1,170 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+func TestPolicy(t *testing.T) {
+	var (
+		overloaded  = xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+		sessionBusy = xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SESSION_BUSY))
+		unavailable = xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, ""))
+		fast        = backoff.New(backoff.WithSlotDuration(time.Millisecond))
+	)
+	for _, tt := range []struct {
+		name     string
+		policy   Policy
+		attempt  Attempt
+		decision Decision
+	}{
+		{
+			name:    "DefaultOverloaded",
+			policy:  NewPolicy(),
+			attempt: Attempt{Attempts: 1, Error: overloaded},
+			decision: Decision{
+				Retry:       true,
+				BackoffType: backoff.TypeSlow,
+			},
+		},
+		{
+			name:    "DefaultSessionBusy",
+			policy:  NewPolicy(),
+			attempt: Attempt{Attempts: 1, Error: sessionBusy},
+			decision: Decision{
+				Retry:         true,
+				BackoffType:   backoff.TypeFast,
+				DeleteSession: true,
+			},
+		},
+		{
+			name: "StatusRule",
+			policy: NewPolicy(WithStatusRule(Ydb.StatusIds_OVERLOADED, Rule{
+				Type: TypeNonRetryable,
+			})),
+			attempt:  Attempt{Attempts: 1, Error: overloaded},
+			decision: Decision{},
+		},
+		{
+			name: "StatusRuleSessionBusyKeepSession",
+			policy: NewPolicy(WithStatusRule(Ydb.StatusIds_SESSION_BUSY, Rule{
+				Type:        TypeRetryable,
+				BackoffType: backoff.TypeFast,
+			})),
+			attempt: Attempt{Attempts: 1, Error: sessionBusy},
+			decision: Decision{
+				Retry:       true,
+				BackoffType: backoff.TypeFast,
+			},
+		},
+		{
+			name: "TransportRuleNonIdempotent",
+			policy: NewPolicy(WithTransportRule(grpcCodes.Unavailable, Rule{
+				Type:        TypeConditionallyRetryable,
+				BackoffType: backoff.TypeSlow,
+			})),
+			attempt:  Attempt{Attempts: 1, Error: unavailable},
+			decision: Decision{BackoffType: backoff.TypeSlow},
+		},
+		{
+			name:    "LimitsOfLabel",
+			policy:  NewPolicy(WithLimits("", Limits{MaxAttempts: 10}), WithLimits("test", Limits{MaxAttempts: 2})),
+			attempt: Attempt{Label: "test", Attempts: 2, Error: overloaded},
+			decision: Decision{
+				BackoffType: backoff.TypeSlow,
+			},
+		},
+		{
+			name:    "LimitsOfAllLabels",
+			policy:  NewPolicy(WithLimits("", Limits{MaxElapsed: time.Second})),
+			attempt: Attempt{Label: "test", Attempts: 2, Elapsed: time.Second, Error: overloaded},
+			decision: Decision{
+				BackoffType: backoff.TypeSlow,
+			},
+		},
+		{
+			name:    "ClassBackoff",
+			policy:  NewPolicy(WithClassBackoff(backoff.TypeFast, fast)),
+			attempt: Attempt{Attempts: 1, Error: sessionBusy},
+			decision: Decision{
+				Retry:         true,
+				BackoffType:   backoff.TypeFast,
+				Backoff:       fast,
+				DeleteSession: true,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.decision, tt.policy.Decide(tt.attempt))
+		})
+	}
+}
+
+type testPolicy func(attempt Attempt) Decision
+
+func (p testPolicy) Decide(attempt Attempt) Decision {
+	return p(attempt)
+}
+
+func TestRetryWithPolicy(t *testing.T) {
+	t.Run("MaxAttempts", func(t *testing.T) {
+		attempts := 0
+		err := Retry(context.Background(), func(ctx context.Context) error {
+			attempts++
+
+			return RetryableError(errors.New("test"), WithBackoff(backoff.TypeNoBackoff))
+		},
+			WithLabel("test"),
+			WithPolicy(NewPolicy(WithLimits("test", Limits{MaxAttempts: 3}))),
+		)
+		require.Error(t, err)
+		require.Equal(t, 3, attempts)
+	})
+	t.Run("DeleteSession", func(t *testing.T) {
+		var (
+			attempts      = 0
+			deleteSession []bool
+		)
+		require.NoError(t, Retry(context.Background(), func(ctx context.Context) error {
+			attempts++
+			if attempts > 1 {
+				return nil
+			}
+			err := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_SESSION))
+			deleteSession = append(deleteSession, xcontext.MustDeleteSession(ctx, err, func(err error) bool {
+				return Check(err).MustDeleteSession()
+			}))
+
+			return err
+		},
+			WithPolicy(testPolicy(func(attempt Attempt) Decision {
+				return Decision{Retry: true}
+			})),
+		))
+		require.Equal(t, 2, attempts)
+		require.Equal(t, []bool{false}, deleteSession)
+	})
+	t.Run("NilPolicy", func(t *testing.T) {
+		attempts := 0
+		err := Retry(context.Background(), func(ctx context.Context) error {
+			attempts++
+
+			return errors.New("test")
+		},
+			WithPolicy(nil),
+		)
+		require.Error(t, err)
+		require.Equal(t, 1, attempts)
+	})
+}
@@ -3,6 +3,7 @@ package retry
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -25,6 +26,9 @@ type retryOptions struct {
 	stackTrace  bool
 	fastBackoff backoff.Backoff
 	slowBackoff backoff.Backoff
+	hedging     *Hedging
+	budget      *Budget
+	policy      Policy
 
 	panicCallback func(e interface{})
 }
@@ -236,6 +240,7 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 		trace:       &trace.Retry{},
 		fastBackoff: backoff.Fast,
 		slowBackoff: backoff.Slow,
+		policy:      defaultPolicy,
 	}
 	for _, opt := range opts {
 		if opt != nil {
@@ -245,6 +250,15 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 	if options.idempotent {
 		ctx = xcontext.WithIdempotent(ctx, options.idempotent)
 	}
+	if options.policy != defaultPolicy {
+		ctx = xcontext.WithDeleteSession(ctx, func(err error) bool {
+			return options.policy.Decide(Attempt{
+				Label:      options.label,
+				Idempotent: options.idempotent,
+				Error:      err,
+			}).DeleteSession
+		})
+	}
 	defer func() {
 		if finalErr != nil && options.stackTrace {
 			finalErr = xerrors.WithStackTrace(finalErr,
@@ -255,6 +269,7 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 	var (
 		i        int
 		attempts int
+		start    = time.Now()
 
 		code           = int64(0)
 		onIntermediate = trace.RetryOnRetry(options.trace, &ctx,
@@ -264,6 +279,14 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 	defer func() {
 		onIntermediate(finalErr)(attempts, finalErr)
 	}()
+	if options.budget != nil {
+		options.budget.call()
+		defer func() {
+			if finalErr == nil {
+				options.budget.success()
+			}
+		}()
+	}
 	for {
 		i++
 		attempts++
@@ -276,7 +299,7 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 			)
 
 		default:
-			err := func() (err error) {
+			attempt := func(ctx context.Context) (err error) {
 				if options.panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
@@ -289,7 +312,14 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 				}
 
 				return op(ctx)
-			}()
+			}
+
+			var err error
+			if options.hedging != nil && options.idempotent && xcontext.IsReadOnly(ctx) {
+				err = options.hedging.do(ctx, attempt, options)
+			} else {
+				err = attempt(ctx)
+			}
 
 			if err == nil {
 				return nil
@@ -310,7 +340,15 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 				i = 0
 			}
 
-			if !m.MustRetry(options.idempotent) {
+			decision := options.policy.Decide(Attempt{
+				Label:      options.label,
+				Idempotent: options.idempotent,
+				Attempts:   attempts,
+				Elapsed:    time.Since(start),
+				Error:      err,
+			})
+
+			if !decision.Retry {
 				return xerrors.WithStackTrace(
 					fmt.Errorf("non-retryable error occurred on attempt No.%d (idempotent=%v): %w",
 						attempts, options.idempotent, err,
@@ -318,7 +356,25 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 				)
 			}
 
-			if e := wait.Wait(ctx, options.fastBackoff, options.slowBackoff, m.BackoffType(), i); e != nil {
+			if options.budget != nil {
+				allowed, tokens, capacity := options.budget.withdraw()
+				trace.RetryOnBudget(options.trace, &ctx, options.call, options.label, tokens, capacity, allowed)
+				if !allowed {
+					return xerrors.WithStackTrace(
+						xerrors.Join(
+							fmt.Errorf("retry rejected on attempt No.%d", attempts),
+							ErrBudgetExhausted, err,
+						),
+					)
+				}
+			}
+
+			fastBackoff, slowBackoff := options.fastBackoff, options.slowBackoff
+			if decision.Backoff != nil {
+				fastBackoff, slowBackoff = decision.Backoff, decision.Backoff
+			}
+
+			if e := wait.Wait(ctx, fastBackoff, slowBackoff, decision.BackoffType, i); e != nil {
 				return xerrors.WithStackTrace(
 					xerrors.Join(
 						fmt.Errorf("wait exit on attempt No.%d",
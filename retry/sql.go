@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
@@ -136,7 +137,8 @@ func DoTx(ctx context.Context, db *sql.DB, op func(context.Context, *sql.Tx) err
 				ReadOnly:  false,
 			},
 		}
-		attempts = 0
+		// attempts is atomic because attempts of hedged read-only transactions are concurrent
+		attempts atomic.Int64
 	)
 	if tracer, has := db.Driver().(interface {
 		TraceRetry() *trace.Retry
@@ -150,8 +152,11 @@ func DoTx(ctx context.Context, db *sql.DB, op func(context.Context, *sql.Tx) err
 			opt.ApplyDoTxOption(&options)
 		}
 	}
+	if options.txOptions.ReadOnly {
+		ctx = xcontext.WithReadOnly(ctx)
+	}
 	err := Retry(ctx, func(ctx context.Context) (finalErr error) {
-		attempts++
+		attempts.Add(1)
 		tx, err := db.BeginTx(ctx, options.txOptions)
 		if err != nil {
 			return unwrapErrBadConn(xerrors.WithStackTrace(err))
@@ -180,7 +185,7 @@ func DoTx(ctx context.Context, db *sql.DB, op func(context.Context, *sql.Tx) err
 	}, options.retryOptions...)
 	if err != nil {
 		return xerrors.WithStackTrace(
-			fmt.Errorf("tx operation failed with %d attempts: %w", attempts, err),
+			fmt.Errorf("tx operation failed with %d attempts: %w", attempts.Load(), err),
 		)
 	}
 
@@ -0,0 +1,242 @@
+package scheme
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ACL is desired access control list of path
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type ACL struct {
+	// Owner is desired owner of path, empty owner means owner is not changed
+	Owner string
+
+	// Permissions are desired explicit permissions of path. Explicit permissions of subjects,
+	// which are not in Permissions, are revoked
+	Permissions []Permissions
+
+	// InterruptInheritance defines inheritance of permissions from parent path, nil means inheritance
+	// is not changed. Entry not describes current inheritance, so not nil InterruptInheritance always
+	// makes not empty change
+	InterruptInheritance *bool
+}
+
+// ACLChange is minimal change of permissions of path, which makes explicit permissions of path equal to ACL
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type ACLChange struct {
+	Path                 string
+	Grant                []Permissions
+	Revoke               []Permissions
+	Owner                string
+	InterruptInheritance *bool
+}
+
+// Empty returns true if permissions of path already equal to ACL
+func (c *ACLChange) Empty() bool {
+	return len(c.Grant) == 0 && len(c.Revoke) == 0 && c.Owner == "" && c.InterruptInheritance == nil
+}
+
+// Options returns options of ModifyPermissions call for the change
+func (c *ACLChange) Options() []PermissionsOption {
+	var opts []PermissionsOption
+	for _, p := range c.Revoke {
+		opts = append(opts, WithRevokePermissions(p))
+	}
+	for _, p := range c.Grant {
+		opts = append(opts, WithGrantPermissions(p))
+	}
+	if c.Owner != "" {
+		opts = append(opts, WithChangeOwner(c.Owner))
+	}
+	if c.InterruptInheritance != nil {
+		opts = append(opts, WithInterruptInheritance(*c.InterruptInheritance))
+	}
+
+	return opts
+}
+
+// Apply makes ModifyPermissions call for the change if change is not empty
+func (c *ACLChange) Apply(ctx context.Context, client Client) error {
+	if c.Empty() {
+		return nil
+	}
+
+	return xerrors.WithStackTrace(client.ModifyPermissions(ctx, c.Path, c.Options()...))
+}
+
+func (c *ACLChange) String() string {
+	var parts []string
+	for _, p := range c.Revoke {
+		parts = append(parts, fmt.Sprintf("revoke %q %v", p.Subject, p.PermissionNames))
+	}
+	for _, p := range c.Grant {
+		parts = append(parts, fmt.Sprintf("grant %q %v", p.Subject, p.PermissionNames))
+	}
+	if c.Owner != "" {
+		parts = append(parts, fmt.Sprintf("owner %q", c.Owner))
+	}
+	if c.InterruptInheritance != nil {
+		parts = append(parts, fmt.Sprintf("interrupt inheritance %t", *c.InterruptInheritance))
+	}
+
+	return c.Path + ": " + strings.Join(parts, ", ")
+}
+
+// DiffACL compares explicit permissions of entry with desired ACL and returns minimal change of permissions
+func DiffACL(path string, entry *Entry, acl ACL) *ACLChange {
+	change := &ACLChange{
+		Path:                 path,
+		InterruptInheritance: acl.InterruptInheritance,
+	}
+	if acl.Owner != "" && acl.Owner != entry.Owner {
+		change.Owner = acl.Owner
+	}
+
+	current := permissionsBySubject(entry.Permissions)
+	desired := permissionsBySubject(acl.Permissions)
+
+	for _, subject := range sortedSubjects(desired) {
+		if names := difference(desired[subject], current[subject]); len(names) > 0 {
+			change.Grant = append(change.Grant, Permissions{Subject: subject, PermissionNames: names})
+		}
+	}
+	for _, subject := range sortedSubjects(current) {
+		if names := difference(current[subject], desired[subject]); len(names) > 0 {
+			change.Revoke = append(change.Revoke, Permissions{Subject: subject, PermissionNames: names})
+		}
+	}
+
+	return change
+}
+
+// PlanACL walks tree rooted at root and returns not empty changes, which make explicit permissions
+// of all entries equal to ACL. Options of walk (for example WithWalkEntryTypes) restrict changed entries.
+// Inheritance is changed only for root, children of root inherit permissions from root.
+// PlanACL describes each visited entry, because listing of directory not contains permissions.
+func PlanACL(ctx context.Context, client Client, root string, acl ACL, opts ...WalkOption) ([]*ACLChange, error) {
+	var changes []*ACLChange
+	err := Walk(ctx, client, root, func(path string, entry *Entry, err error) error {
+		if err != nil {
+			return err
+		}
+		acl := acl
+		if path != root {
+			acl.InterruptInheritance = nil
+			// listing of directory not contains permissions of children
+			described, err := client.DescribePath(ctx, path)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			entry = &described
+		}
+		if change := DiffACL(path, entry, acl); !change.Empty() {
+			changes = append(changes, change)
+		}
+
+		return nil
+	}, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes, nil
+}
+
+// SyncACL makes explicit permissions of all entries of tree rooted at root equal to ACL
+// and returns applied changes
+func SyncACL(ctx context.Context, client Client, root string, acl ACL, opts ...WalkOption) ([]*ACLChange, error) {
+	changes, err := PlanACL(ctx, client, root, acl, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	for i, change := range changes {
+		if err = change.Apply(ctx, client); err != nil {
+			return changes[:i], xerrors.WithStackTrace(fmt.Errorf("%s: %w", change, err))
+		}
+	}
+
+	return changes, nil
+}
+
+// EffectiveRights returns sorted names of permissions of subjects on path, including permissions inherited
+// from parent paths. Subjects are user and its groups. Owner of path has all rights, so owner gets
+// "ydb.generic.full" permission.
+func EffectiveRights(ctx context.Context, client Client, path string, subjects ...string) ([]string, error) {
+	entry, err := client.DescribePath(ctx, path)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	permissions := permissionsBySubject(entry.EffectivePermissions)
+	rights := make(map[string]struct{})
+	for _, subject := range subjects {
+		if subject == entry.Owner {
+			rights["ydb.generic.full"] = struct{}{}
+		}
+		for name := range permissions[subject] {
+			rights[name] = struct{}{}
+		}
+	}
+
+	names := make([]string, 0, len(rights))
+	for name := range rights {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+func permissionsBySubject(permissions []Permissions) map[string]map[string]struct{} {
+	bySubject := make(map[string]map[string]struct{}, len(permissions))
+	for _, p := range permissions {
+		names, has := bySubject[p.Subject]
+		if !has {
+			names = make(map[string]struct{}, len(p.PermissionNames))
+			bySubject[p.Subject] = names
+		}
+		for _, name := range p.PermissionNames {
+			names[name] = struct{}{}
+		}
+	}
+
+	return bySubject
+}
+
+func sortedSubjects(bySubject map[string]map[string]struct{}) []string {
+	subjects := make([]string, 0, len(bySubject))
+	for subject := range bySubject {
+		subjects = append(subjects, subject)
+	}
+	sort.Strings(subjects)
+
+	return subjects
+}
+
+// difference returns sorted names from lhs, which are not in rhs
+func difference(lhs, rhs map[string]struct{}) []string {
+	var names []string
+	for name := range lhs {
+		if _, has := rhs[name]; !has {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
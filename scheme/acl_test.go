@@ -0,0 +1,172 @@
+package scheme
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+)
+
+type testPermissionsDesc struct {
+	actions              []*Ydb_Scheme.PermissionsAction
+	interruptInheritance *bool
+}
+
+func (d *testPermissionsDesc) SetClear(bool) {}
+
+func (d *testPermissionsDesc) SetInterruptInheritance(interrupt bool) {
+	d.interruptInheritance = &interrupt
+}
+
+func (d *testPermissionsDesc) AppendAction(action *Ydb_Scheme.PermissionsAction) {
+	d.actions = append(d.actions, action)
+}
+
+type testACLClient struct {
+	*testSchemeClient
+
+	entries  map[string]Entry
+	modified map[string]*testPermissionsDesc
+}
+
+func (c *testACLClient) DescribePath(ctx context.Context, path string) (Entry, error) {
+	entry, err := c.testSchemeClient.DescribePath(ctx, path)
+	if err != nil {
+		return entry, err
+	}
+	entry.Owner = c.entries[path].Owner
+	entry.Permissions = c.entries[path].Permissions
+	entry.EffectivePermissions = c.entries[path].EffectivePermissions
+
+	return entry, nil
+}
+
+func (c *testACLClient) ModifyPermissions(ctx context.Context, path string, opts ...PermissionsOption) error {
+	desc := &testPermissionsDesc{}
+	for _, opt := range opts {
+		opt(desc)
+	}
+	c.modified[path] = desc
+
+	return nil
+}
+
+func TestDiffACL(t *testing.T) {
+	interrupt := true
+	change := DiffACL("/local/table", &Entry{
+		Owner: "root",
+		Permissions: []Permissions{
+			{Subject: "alice", PermissionNames: []string{"ydb.generic.read", "ydb.generic.write"}},
+			{Subject: "bob", PermissionNames: []string{"ydb.generic.read"}},
+		},
+	}, ACL{
+		Owner: "admin",
+		Permissions: []Permissions{
+			{Subject: "alice", PermissionNames: []string{"ydb.generic.read"}},
+			{Subject: "carol", PermissionNames: []string{"ydb.generic.read"}},
+			{Subject: "carol", PermissionNames: []string{"ydb.generic.list"}},
+		},
+		InterruptInheritance: &interrupt,
+	})
+	require.Equal(t, []Permissions{
+		{Subject: "carol", PermissionNames: []string{"ydb.generic.list", "ydb.generic.read"}},
+	}, change.Grant)
+	require.Equal(t, []Permissions{
+		{Subject: "alice", PermissionNames: []string{"ydb.generic.write"}},
+		{Subject: "bob", PermissionNames: []string{"ydb.generic.read"}},
+	}, change.Revoke)
+	require.Equal(t, "admin", change.Owner)
+	require.Len(t, change.Options(), 5)
+	require.Equal(t, `/local/table: revoke "alice" [ydb.generic.write], revoke "bob" [ydb.generic.read], `+
+		`grant "carol" [ydb.generic.list ydb.generic.read], owner "admin", interrupt inheritance true`, change.String())
+
+	change = DiffACL("/local/table", &Entry{
+		Owner: "admin",
+		Permissions: []Permissions{
+			{Subject: "alice", PermissionNames: []string{"ydb.generic.read"}},
+		},
+	}, ACL{
+		Owner: "admin",
+		Permissions: []Permissions{
+			{Subject: "alice", PermissionNames: []string{"ydb.generic.read"}},
+		},
+	})
+	require.True(t, change.Empty())
+}
+
+func TestSyncACL(t *testing.T) {
+	ctx := context.Background()
+	readers := []Permissions{{Subject: "readers", PermissionNames: []string{"ydb.generic.read"}}}
+	client := &testACLClient{
+		testSchemeClient: newTestSchemeClient("/local/dir/a", "/local/dir/b", "/local/other"),
+		entries: map[string]Entry{
+			"/local/dir":   {Owner: "root"},
+			"/local/dir/a": {Owner: "root", Permissions: readers},
+			"/local/dir/b": {
+				Owner: "root",
+				Permissions: []Permissions{
+					{Subject: "writers", PermissionNames: []string{"ydb.generic.write"}},
+				},
+			},
+		},
+		modified: make(map[string]*testPermissionsDesc),
+	}
+
+	interrupt := true
+	acl := ACL{Permissions: readers, InterruptInheritance: &interrupt}
+
+	changes, err := PlanACL(ctx, client, "/local/dir", acl)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, "/local/dir", changes[0].Path)
+	require.Equal(t, readers, changes[0].Grant)
+	require.Equal(t, &interrupt, changes[0].InterruptInheritance)
+	require.Equal(t, "/local/dir/b", changes[1].Path)
+	require.Nil(t, changes[1].InterruptInheritance)
+	require.Empty(t, client.modified)
+
+	changes, err = SyncACL(ctx, client, "/local/dir", acl)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Len(t, client.modified, 2)
+	require.Equal(t, &interrupt, client.modified["/local/dir"].interruptInheritance)
+	require.Len(t, client.modified["/local/dir"].actions, 1)
+	require.Equal(t, "readers", client.modified["/local/dir"].actions[0].GetGrant().GetSubject())
+	require.Nil(t, client.modified["/local/dir/b"].interruptInheritance)
+	require.Len(t, client.modified["/local/dir/b"].actions, 2)
+	require.Equal(t, "writers", client.modified["/local/dir/b"].actions[0].GetRevoke().GetSubject())
+	require.Equal(t, "readers", client.modified["/local/dir/b"].actions[1].GetGrant().GetSubject())
+}
+
+func TestEffectiveRights(t *testing.T) {
+	ctx := context.Background()
+	client := &testACLClient{
+		testSchemeClient: newTestSchemeClient("/local/table"),
+		entries: map[string]Entry{
+			"/local/table": {
+				Owner: "admin",
+				EffectivePermissions: []Permissions{
+					{Subject: "alice", PermissionNames: []string{"ydb.generic.read"}},
+					{Subject: "developers", PermissionNames: []string{"ydb.generic.write", "ydb.generic.read"}},
+				},
+			},
+		},
+	}
+
+	rights, err := EffectiveRights(ctx, client, "/local/table", "alice")
+	require.NoError(t, err)
+	require.Equal(t, []string{"ydb.generic.read"}, rights)
+
+	rights, err = EffectiveRights(ctx, client, "/local/table", "alice", "developers")
+	require.NoError(t, err)
+	require.Equal(t, []string{"ydb.generic.read", "ydb.generic.write"}, rights)
+
+	rights, err = EffectiveRights(ctx, client, "/local/table", "admin")
+	require.NoError(t, err)
+	require.Equal(t, []string{"ydb.generic.full"}, rights)
+
+	rights, err = EffectiveRights(ctx, client, "/local/table", "bob")
+	require.NoError(t, err)
+	require.Empty(t, rights)
+}
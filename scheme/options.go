@@ -13,6 +13,7 @@ func permissions(p Permissions) *Ydb_Scheme.Permissions {
 
 type permissionsDesc interface {
 	SetClear(clear bool)
+	SetInterruptInheritance(interrupt bool)
 	AppendAction(action *Ydb_Scheme.PermissionsAction)
 }
 
@@ -24,6 +25,14 @@ func WithClearPermissions() PermissionsOption {
 	}
 }
 
+// WithInterruptInheritance interrupts (or restores if interrupt is false) inheritance
+// of permissions from parent path
+func WithInterruptInheritance(interrupt bool) PermissionsOption {
+	return func(p permissionsDesc) {
+		p.SetInterruptInheritance(interrupt)
+	}
+}
+
 func WithGrantPermissions(p Permissions) PermissionsOption {
 	return func(d permissionsDesc) {
 		d.AppendAction(&Ydb_Scheme.PermissionsAction{
@@ -0,0 +1,304 @@
+package scheme
+
+import (
+	"context"
+	"errors"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const sysDirectory = ".sys"
+
+var (
+	// SkipDir is used as a return value from WalkFunc to indicate that the directory named in the call
+	// is to be skipped. If WalkFunc returns SkipDir on a non-directory entry, Walk skips the remaining
+	// entries in the containing directory
+	SkipDir = errors.New("skip this directory") //nolint:revive,stylecheck
+
+	// SkipAll is used as a return value from WalkFunc to indicate that all remaining entries are to be skipped
+	SkipAll = errors.New("skip everything and stop the walk") //nolint:revive,stylecheck
+)
+
+// WalkFunc is the type of the function called by Walk to visit each entry.
+// Path is absolute path of entry. If listing of directory failed, WalkFunc called second time
+// for the directory with the error, WalkFunc may return nil for continue the walk.
+type WalkFunc func(path string, entry *Entry, err error) error
+
+type walkOptions struct {
+	entryTypes  map[EntryType]struct{}
+	concurrency int
+	skipSys     bool
+}
+
+// WalkOption is option for Walk and Glob
+type WalkOption func(o *walkOptions)
+
+// WithWalkEntryTypes defines types of entries for call of WalkFunc.
+// Directories of other types are walked, but WalkFunc is not called for them.
+func WithWalkEntryTypes(types ...EntryType) WalkOption {
+	return func(o *walkOptions) {
+		o.entryTypes = make(map[EntryType]struct{}, len(types))
+		for _, t := range types {
+			o.entryTypes[t] = struct{}{}
+		}
+	}
+}
+
+// WithWalkConcurrency defines max count of concurrent listings of directories, default 1.
+// Calls of WalkFunc are serialized, but order of entries is not defined if concurrency greater than 1.
+func WithWalkConcurrency(concurrency int) WalkOption {
+	return func(o *walkOptions) {
+		o.concurrency = concurrency
+	}
+}
+
+// WithoutSysDirectories excludes system directories `.sys` from the walk
+func WithoutSysDirectories() WalkOption {
+	return func(o *walkOptions) {
+		o.skipSys = true
+	}
+}
+
+// Walk walks the scheme tree rooted at root, calling fn for each entry in the tree, including root.
+// Walk is modelled on filepath.WalkDir: directory visited before its children and fn may return
+// SkipDir or SkipAll for skip part of tree. Entries of directory visited in lexical order if
+// concurrency is not defined. If ctx is done before the walk completed, Walk returns ctx.Err().
+func Walk(ctx context.Context, client Client, root string, fn WalkFunc, opts ...WalkOption) error {
+	w := &walker{
+		client: client,
+		fn:     fn,
+		options: walkOptions{
+			concurrency: 1,
+		},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&w.options)
+		}
+	}
+	if w.options.concurrency > 1 {
+		w.sem = make(chan struct{}, w.options.concurrency)
+	}
+
+	parentCtx := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w.cancel = cancel
+
+	entry, err := client.DescribePath(ctx, root)
+	if err != nil {
+		if parentCtx.Err() != nil {
+			return xerrors.WithStackTrace(parentCtx.Err())
+		}
+		if err = w.call(root, nil, err); err != nil {
+			return w.result(err)
+		}
+
+		return nil
+	}
+
+	if err = w.call(root, &entry, nil); err != nil || !isDirectory(&entry) {
+		return w.result(err)
+	}
+
+	w.walkDir(ctx, root, &entry)
+	w.wg.Wait()
+
+	if w.err == nil && parentCtx.Err() != nil {
+		return xerrors.WithStackTrace(parentCtx.Err())
+	}
+
+	return w.result(w.err)
+}
+
+type walker struct {
+	client  Client
+	fn      WalkFunc
+	options walkOptions
+	sem     chan struct{}
+	wg      sync.WaitGroup
+	cancel  context.CancelFunc
+
+	mutex   sync.Mutex
+	stopped bool
+	err     error
+}
+
+func (w *walker) result(err error) error {
+	if errors.Is(err, SkipDir) || errors.Is(err, SkipAll) {
+		return nil
+	}
+
+	return err
+}
+
+// call calls fn for entry if entry is not filtered out
+func (w *walker) call(p string, entry *Entry, err error) error {
+	if entry != nil && err == nil && w.options.entryTypes != nil {
+		if _, has := w.options.entryTypes[entry.Type]; !has {
+			return nil
+		}
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.stopped {
+		return SkipAll
+	}
+
+	return w.fn(p, entry, err)
+}
+
+func (w *walker) stop(err error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if !w.stopped {
+		w.stopped = true
+		w.err = err
+		w.cancel()
+	}
+}
+
+func (w *walker) list(ctx context.Context, p string) (Directory, error) {
+	if w.sem != nil {
+		select {
+		case <-ctx.Done():
+			return Directory{}, xerrors.WithStackTrace(ctx.Err())
+		case w.sem <- struct{}{}:
+		}
+		defer func() {
+			<-w.sem
+		}()
+	}
+
+	dir, err := w.client.ListDirectory(ctx, p)
+	if err != nil {
+		return dir, xerrors.WithStackTrace(err)
+	}
+
+	return dir, nil
+}
+
+func (w *walker) walkDir(ctx context.Context, dirPath string, dirEntry *Entry) {
+	dir, err := w.list(ctx, dirPath)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		if err = w.call(dirPath, dirEntry, err); err != nil && !errors.Is(err, SkipDir) {
+			w.stop(err)
+		}
+
+		return
+	}
+
+	children := dir.Children
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].Name < children[j].Name
+	})
+
+	for i := range children {
+		child := &children[i]
+		if w.options.skipSys && child.Name == sysDirectory {
+			continue
+		}
+		childPath := path.Join(dirPath, child.Name)
+
+		err = w.call(childPath, child, nil)
+		if err != nil {
+			if errors.Is(err, SkipDir) {
+				if isDirectory(child) {
+					continue
+				}
+
+				return
+			}
+			w.stop(err)
+
+			return
+		}
+
+		if !isDirectory(child) {
+			continue
+		}
+		if w.sem == nil {
+			w.walkDir(ctx, childPath, child)
+			if ctx.Err() != nil {
+				return
+			}
+
+			continue
+		}
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.walkDir(ctx, childPath, child)
+		}()
+	}
+}
+
+func isDirectory(entry *Entry) bool {
+	return entry.Type == EntryDirectory || entry.Type == EntryDatabase
+}
+
+// Glob returns absolute paths of entries, which match pattern. The pattern syntax is the same as in path.Match,
+// pattern must be absolute path. Glob lists only directories, which may contain matched entries, and ignores
+// errors of listing like filepath.Glob.
+//
+// For example, Glob(ctx, client, "/local/tenant_*/orders") returns orders entries of all tenant directories.
+func Glob(ctx context.Context, client Client, pattern string, opts ...WalkOption) ([]string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	segments := strings.Split(strings.Trim(pattern, "/"), "/")
+	static := 0
+	for static < len(segments)-1 && !hasMeta(segments[static]) {
+		static++
+	}
+
+	var (
+		matches []string
+		mutex   sync.Mutex
+	)
+	err := Walk(ctx, client, "/"+strings.Join(segments[:static], "/"), func(p string, entry *Entry, err error) error {
+		if err != nil {
+			return SkipDir
+		}
+		depth := 0
+		if trimmed := strings.Trim(p, "/"); trimmed != "" {
+			depth = strings.Count(trimmed, "/") + 1
+		}
+		if depth <= static {
+			return nil
+		}
+		matched, _ := path.Match("/"+strings.Join(segments[:depth], "/"), p)
+		if matched && depth == len(segments) {
+			mutex.Lock()
+			matches = append(matches, p)
+			mutex.Unlock()
+		}
+		if isDirectory(entry) && (!matched || depth == len(segments)) {
+			return SkipDir
+		}
+
+		return nil
+	}, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	sort.Strings(matches)
+
+	return matches, nil
+}
+
+func hasMeta(segment string) bool {
+	return strings.ContainsAny(segment, `*?[\`)
+}
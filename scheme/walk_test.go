@@ -0,0 +1,251 @@
+package scheme
+
+import (
+	"context"
+	"errors"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errTestListing = errors.New("test listing error")
+
+type testSchemeClient struct {
+	Client
+
+	entries map[string]EntryType
+	broken  map[string]bool
+
+	mutex         sync.Mutex
+	listings      int
+	inflight      int
+	inflightLimit int
+}
+
+func newTestSchemeClient(paths ...string) *testSchemeClient {
+	c := &testSchemeClient{
+		entries: map[string]EntryType{"/local": EntryDatabase},
+		broken:  make(map[string]bool),
+	}
+	for _, p := range paths {
+		entryType := EntryTable
+		if strings.HasSuffix(p, "/") {
+			entryType = EntryDirectory
+		}
+		p = strings.TrimSuffix(p, "/")
+		c.entries[p] = entryType
+		for dir := path.Dir(p); dir != "/local"; dir = path.Dir(dir) {
+			c.entries[dir] = EntryDirectory
+		}
+	}
+
+	return c
+}
+
+func (c *testSchemeClient) DescribePath(ctx context.Context, p string) (Entry, error) {
+	entryType, has := c.entries[p]
+	if !has {
+		return Entry{}, errTestListing
+	}
+
+	return Entry{Name: path.Base(p), Type: entryType}, nil
+}
+
+func (c *testSchemeClient) ListDirectory(ctx context.Context, p string) (Directory, error) {
+	c.mutex.Lock()
+	c.listings++
+	c.inflight++
+	if c.inflight > c.inflightLimit {
+		c.inflightLimit = c.inflight
+	}
+	c.mutex.Unlock()
+
+	defer func() {
+		c.mutex.Lock()
+		c.inflight--
+		c.mutex.Unlock()
+	}()
+
+	time.Sleep(time.Millisecond)
+
+	if c.broken[p] {
+		return Directory{}, errTestListing
+	}
+
+	dir := Directory{Entry: Entry{Name: path.Base(p), Type: c.entries[p]}}
+	for child, entryType := range c.entries {
+		if path.Dir(child) == p {
+			dir.Children = append(dir.Children, Entry{Name: path.Base(child), Type: entryType})
+		}
+	}
+
+	return dir, nil
+}
+
+func TestWalk(t *testing.T) {
+	ctx := context.Background()
+	client := newTestSchemeClient(
+		"/local/.sys/partition_stats",
+		"/local/b/table",
+		"/local/a/table",
+		"/local/a/dir/table",
+		"/local/a/empty/",
+	)
+
+	t.Run("All", func(t *testing.T) {
+		var paths []string
+		require.NoError(t, Walk(ctx, client, "/local", func(p string, entry *Entry, err error) error {
+			require.NoError(t, err)
+			paths = append(paths, p)
+
+			return nil
+		}))
+		require.Equal(t, []string{
+			"/local",
+			"/local/.sys",
+			"/local/.sys/partition_stats",
+			"/local/a",
+			"/local/a/dir",
+			"/local/a/dir/table",
+			"/local/a/empty",
+			"/local/a/table",
+			"/local/b",
+			"/local/b/table",
+		}, paths)
+	})
+	t.Run("SkipDir", func(t *testing.T) {
+		var paths []string
+		require.NoError(t, Walk(ctx, client, "/local", func(p string, entry *Entry, err error) error {
+			paths = append(paths, p)
+			if p == "/local/a" || p == "/local/b/table" {
+				return SkipDir
+			}
+
+			return nil
+		}, WithoutSysDirectories()))
+		require.Equal(t, []string{"/local", "/local/a", "/local/b", "/local/b/table"}, paths)
+	})
+	t.Run("SkipAll", func(t *testing.T) {
+		var paths []string
+		require.NoError(t, Walk(ctx, client, "/local", func(p string, entry *Entry, err error) error {
+			paths = append(paths, p)
+			if p == "/local/a/dir" {
+				return SkipAll
+			}
+
+			return nil
+		}, WithoutSysDirectories()))
+		require.Equal(t, []string{"/local", "/local/a", "/local/a/dir"}, paths)
+	})
+	t.Run("EntryTypes", func(t *testing.T) {
+		var paths []string
+		require.NoError(t, Walk(ctx, client, "/local", func(p string, entry *Entry, err error) error {
+			require.Equal(t, EntryTable, entry.Type)
+			paths = append(paths, p)
+
+			return nil
+		}, WithoutSysDirectories(), WithWalkEntryTypes(EntryTable)))
+		require.Equal(t, []string{"/local/a/dir/table", "/local/a/table", "/local/b/table"}, paths)
+	})
+	t.Run("Error", func(t *testing.T) {
+		client := newTestSchemeClient("/local/a/table", "/local/b/table")
+		client.broken["/local/a"] = true
+
+		var failed []string
+		err := Walk(ctx, client, "/local", func(p string, entry *Entry, err error) error {
+			if err != nil {
+				failed = append(failed, p)
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"/local/a"}, failed)
+
+		err = Walk(ctx, client, "/local", func(p string, entry *Entry, err error) error {
+			return err
+		})
+		require.ErrorIs(t, err, errTestListing)
+	})
+}
+
+func TestWalkConcurrency(t *testing.T) {
+	ctx := context.Background()
+	var paths []string
+	for i := 0; i < 10; i++ {
+		for j := 0; j < 10; j++ {
+			paths = append(paths, path.Join("/local", string(rune('a'+i)), string(rune('a'+j)), "table"))
+		}
+	}
+	client := newTestSchemeClient(paths...)
+
+	var tables []string
+	require.NoError(t, Walk(ctx, client, "/local", func(p string, entry *Entry, err error) error {
+		require.NoError(t, err)
+		tables = append(tables, p)
+
+		return nil
+	}, WithWalkConcurrency(4), WithWalkEntryTypes(EntryTable)))
+
+	sort.Strings(tables)
+	require.Equal(t, paths, tables)
+	require.Equal(t, 111, client.listings)
+	require.LessOrEqual(t, client.inflightLimit, 4)
+	require.Greater(t, client.inflightLimit, 1)
+}
+
+func TestWalkCancelled(t *testing.T) {
+	client := newTestSchemeClient("/local/a/table", "/local/b/table")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := Walk(ctx, client, "/local", func(p string, entry *Entry, err error) error {
+		if p == "/local/a" {
+			cancel()
+		}
+
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = Glob(ctx, client, "/local/*/table")
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestGlob(t *testing.T) {
+	ctx := context.Background()
+	client := newTestSchemeClient(
+		"/local/tenant_1/orders",
+		"/local/tenant_1/users",
+		"/local/tenant_2/orders",
+		"/local/tenant_2/nested/orders",
+		"/local/other/orders",
+	)
+
+	matches, err := Glob(ctx, client, "/local/tenant_*/orders")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/local/tenant_1/orders", "/local/tenant_2/orders"}, matches)
+	// only /local and tenant directories are listed
+	require.Equal(t, 3, client.listings)
+
+	matches, err = Glob(ctx, client, "/local/*/orders", WithWalkConcurrency(2))
+	require.NoError(t, err)
+	require.Equal(t, []string{"/local/other/orders", "/local/tenant_1/orders", "/local/tenant_2/orders"}, matches)
+
+	matches, err = Glob(ctx, client, "/local/tenant_1/users")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/local/tenant_1/users"}, matches)
+
+	matches, err = Glob(ctx, client, "/local/unknown/*")
+	require.NoError(t, err)
+	require.Empty(t, matches)
+
+	_, err = Glob(ctx, client, "/local/[")
+	require.Error(t, err)
+}
@@ -0,0 +1,73 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicsugar"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+func TestTopicTableOffsetStore(t *testing.T) {
+	ctx := xtest.Context(t)
+	scope := newScope(t)
+	db := scope.Driver()
+
+	store := topicsugar.NewTableOffsetStore(db.Table(), path.Join(scope.Folder(), "offsets"), "test")
+	require.NoError(t, store.CreateTable(ctx))
+
+	writer := scope.TopicWriter()
+	require.NoError(t, writer.Write(ctx,
+		topicwriter.Message{Data: bytes.NewReader([]byte("1"))},
+		topicwriter.Message{Data: bytes.NewReader([]byte("2"))},
+	))
+
+	readMessageAndStoreOffset := func() string {
+		reader, err := db.Topic().StartReader(
+			scope.TopicConsumerName(),
+			topicoptions.ReadTopic(scope.TopicPath()),
+			store.ReaderOptions()...,
+		)
+		require.NoError(t, err)
+		defer func() {
+			_ = reader.Close(ctx)
+		}()
+
+		msg, err := reader.ReadMessage(ctx)
+		require.NoError(t, err)
+
+		var content string
+		require.NoError(t, topicsugar.ReadMessageDataWithCallback(msg, func(data []byte) error {
+			content = string(data)
+
+			return nil
+		}))
+
+		err = db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+			return store.CommitInTableTx(ctx, tx, msg)
+		})
+		require.NoError(t, err)
+
+		// second commit of same message rejected
+		err = db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+			return store.CommitInTableTx(ctx, tx, msg)
+		})
+		require.True(t, errors.Is(err, topicsugar.ErrOffsetAlreadyStored))
+
+		return content
+	}
+
+	require.Equal(t, "1", readMessageAndStoreOffset())
+	require.Equal(t, "2", readMessageAndStoreOffset())
+}
@@ -3,6 +3,7 @@ package topicreader
 import (
 	"context"
 	"sync/atomic"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -14,15 +15,17 @@ import (
 // In other words you can have one goroutine for read messages and one goroutine for commit messages.
 //
 // Concurrency table
-// | Method           | ReadMessage | ReadMessageBatch | Commit | Close |
-// | ReadMessage      |      -      |         -        |   +    | -     |
-// | ReadMessageBatch |      -      |         -        |   +    | -     |
-// | Commit           |      +      |         +        |   -    | -     |
-// | Close            |      -      |         -        |   -    | -     |.
+// | Method           | ReadMessage | ReadMessageBatch | Commit | Seek* | Close |
+// | ReadMessage      |      -      |         -        |   +    |   +   | -     |
+// | ReadMessageBatch |      -      |         -        |   +    |   +   | -     |
+// | Commit           |      +      |         +        |   -    |   +   | -     |
+// | Seek*            |      +      |         +        |   +    |   -   | -     |
+// | Close            |      -      |         -        |   -    |   -   | -     |.
 type Reader struct {
 	reader         topicreaderinternal.Reader
 	readInFlyght   atomic.Bool
 	commitInFlyght atomic.Bool
+	seekInFlyght   atomic.Bool
 }
 
 // NewReader
@@ -74,6 +77,23 @@ func (r *Reader) Commit(ctx context.Context, obj CommitRangeGetter) error {
 // CommitRangeGetter interface for get commit offsets.
 type CommitRangeGetter = topicreaderinternal.PublicCommitRangeGetter
 
+// CommitRange contains topic, partition and offsets of committed messages range
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type CommitRange = topicreaderinternal.PublicCommitRange
+
+// GetCommitRange return range of offsets, which will be committed by Commit call with obj
+// it is useful for store read progress in external storage.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func GetCommitRange(obj CommitRangeGetter) CommitRange {
+	return topicreaderinternal.PublicGetCommitRange(obj)
+}
+
 // ReadMessageBatch
 // Deprecated: (was experimental) will be removed soon.
 // Use ReadMessagesBatch instead.
@@ -105,6 +125,41 @@ type Batch = topicreaderinternal.PublicBatch
 // ReadBatchOption is type for options of read batch.
 type ReadBatchOption = topicreaderinternal.PublicReadBatchOption
 
+// SeekPartition move read position of the partition to the offset without recreate the reader.
+//
+// The reader restarts read stream: all buffered messages are dropped, batches from previous stream
+// are skipped (their contexts cancelled), all partition sessions start again. The partition starts
+// read from the offset, other partitions continue from committed offsets.
+// If commits enabled - committed offset of the partition moved to the offset too.
+//
+// Seek is applied when the partition is started by the reader next time, if the partition read by other reader
+// now - the seek will applied after the partition will be routed to the reader.
+func (r *Reader) SeekPartition(ctx context.Context, topic string, partitionID int64, offset int64) error {
+	if err := r.inCall(&r.seekInFlyght); err != nil {
+		return err
+	}
+	defer r.outCall(&r.seekInFlyght)
+
+	return r.reader.SeekPartition(ctx, topic, partitionID, offset)
+}
+
+// SeekTime skip messages written before readFrom in all partitions of the reader without recreate the reader.
+//
+// The reader restarts read stream: all buffered messages are dropped, batches from previous stream
+// are skipped (their contexts cancelled), all partition sessions start again.
+// The time passed to server as read from time of all next streams (include streams after reconnect),
+// partitions are read from committed offset and messages written before readFrom are skipped.
+// SeekTime not rewind partitions behind committed offset, use SeekPartition for reread committed messages.
+// The seek cancel previous SeekPartition requests, which are not applied yet.
+func (r *Reader) SeekTime(ctx context.Context, readFrom time.Time) error {
+	if err := r.inCall(&r.seekInFlyght); err != nil {
+		return err
+	}
+	defer r.outCall(&r.seekInFlyght)
+
+	return r.reader.SeekTime(ctx, readFrom)
+}
+
 // Close stop work with reader
 // return when reader complete internal works, flush commit buffer, ets
 // or when ctx cancelled.
@@ -121,6 +176,11 @@ func (r *Reader) Close(ctx context.Context) error {
 	}
 	defer r.outCall(&r.commitInFlyght)
 
+	if err := r.inCall(&r.seekInFlyght); err != nil {
+		return err
+	}
+	defer r.outCall(&r.seekInFlyght)
+
 	return r.reader.Close(ctx)
 }
 
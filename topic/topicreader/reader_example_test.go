@@ -2,6 +2,7 @@ package topicreader_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
 )
@@ -30,6 +31,34 @@ func ExampleReader_Commit() {
 	}
 }
 
+func ExampleReader_SeekPartition() {
+	ctx := context.TODO()
+	reader := readerConnect()
+
+	// reread partition 1 of the topic from offset 100
+	_ = reader.SeekPartition(ctx, "topic", 1, 100)
+
+	for {
+		msg, _ := reader.ReadMessage(ctx)
+		processMessage(msg.Context(), msg)
+		_ = reader.Commit(msg.Context(), msg)
+	}
+}
+
+func ExampleReader_SeekTime() {
+	ctx := context.TODO()
+	reader := readerConnect()
+
+	// replay all partitions from messages written hour ago
+	_ = reader.SeekTime(ctx, time.Now().Add(-time.Hour))
+
+	for {
+		msg, _ := reader.ReadMessage(ctx)
+		processMessage(msg.Context(), msg)
+		_ = reader.Commit(msg.Context(), msg)
+	}
+}
+
 func ExampleReader_ReadMessageBatch() {
 	ctx := context.TODO()
 	reader := readerConnect()
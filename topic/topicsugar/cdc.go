@@ -0,0 +1,268 @@
+package topicsugar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+var (
+	errCDCEventWithoutKey      = xerrors.Wrap(errors.New("ydb: cdc event without key"))
+	errCDCUnexpectedOperation  = xerrors.Wrap(errors.New("ydb: cdc event has unexpected operation"))
+	errCDCBadTimestamp         = xerrors.Wrap(errors.New("ydb: cdc event has bad timestamp"))
+	errCDCKeyColumnsCountDiffs = xerrors.Wrap(errors.New("ydb: cdc event key columns count differs from key type"))
+)
+
+// CDCOperation is kind of changefeed record
+type CDCOperation int
+
+const (
+	CDCOperationUnknown CDCOperation = iota
+
+	// CDCOperationUpdate - row was inserted, updated or upserted
+	CDCOperationUpdate
+
+	// CDCOperationErase - row was deleted
+	CDCOperationErase
+
+	// CDCOperationResolvedTimestamp - heartbeat record, all changes before the timestamp already sent to the topic
+	CDCOperationResolvedTimestamp
+)
+
+func (o CDCOperation) String() string {
+	switch o {
+	case CDCOperationUpdate:
+		return "Update"
+	case CDCOperationErase:
+		return "Erase"
+	case CDCOperationResolvedTimestamp:
+		return "ResolvedTimestamp"
+	default:
+		return "Unknown"
+	}
+}
+
+// CDCTimestamp is virtual timestamp of change: step and transaction id
+// it filled for changefeeds with VIRTUAL_TIMESTAMPS option and for resolved timestamp records.
+type CDCTimestamp struct {
+	Step uint64
+	TxID uint64
+}
+
+// IsZero return true if timestamp was not set
+func (ts CDCTimestamp) IsZero() bool {
+	return ts.Step == 0 && ts.TxID == 0
+}
+
+// CDCEvent is typed changefeed record of JSON format.
+//
+// Key contains primary key of changed row. If K is struct - key columns assigned to exported fields of the struct
+// in order of declaration, if K is slice or array - key columns assigned to items,
+// other types allowed for tables with one column in primary key.
+//
+// Row images are decoded to V with encoding/json, use json tags for map column names to fields.
+// Filled fields depends on changefeed mode (options.ChangefeedMode):
+//   - KEYS_ONLY: Key only
+//   - UPDATES: Update with changed columns only, other fields of Update are zero values
+//   - NEW_IMAGE: NewImage
+//   - OLD_IMAGE: OldImage
+//   - NEW_AND_OLD_IMAGES: NewImage and OldImage
+//
+// NewImage is nil for erase operation, OldImage is nil for insert new row.
+// For CDCOperationResolvedTimestamp record only Operation and Timestamp are filled.
+type CDCEvent[K, V any] struct {
+	Operation CDCOperation
+	Key       K
+	Update    *V
+	NewImage  *V
+	OldImage  *V
+	Timestamp CDCTimestamp
+}
+
+// UnmarshalCDCEvent decode changefeed record in JSON format from the message
+func UnmarshalCDCEvent[K, V any](msg *topicreader.Message) (*CDCEvent[K, V], error) {
+	var res *CDCEvent[K, V]
+	err := ReadMessageDataWithCallback(msg, func(data []byte) (err error) {
+		res, err = ParseCDCEvent[K, V](data)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type cdcRawEvent struct {
+	Key      json.RawMessage `json:"key"`
+	Update   json.RawMessage `json:"update"`
+	Erase    json.RawMessage `json:"erase"`
+	NewImage json.RawMessage `json:"newImage"`
+	OldImage json.RawMessage `json:"oldImage"`
+	TS       []uint64        `json:"ts"`
+	Resolved []uint64        `json:"resolved"`
+}
+
+// ParseCDCEvent decode changefeed record in JSON format from data
+func ParseCDCEvent[K, V any](data []byte) (*CDCEvent[K, V], error) {
+	var raw cdcRawEvent
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: failed to unmarshal cdc event: %w", err))
+	}
+
+	res := &CDCEvent[K, V]{}
+
+	if raw.Resolved != nil {
+		ts, err := newCDCTimestamp(raw.Resolved)
+		if err != nil {
+			return nil, err
+		}
+		res.Operation = CDCOperationResolvedTimestamp
+		res.Timestamp = ts
+
+		return res, nil
+	}
+
+	switch {
+	case raw.Erase != nil:
+		res.Operation = CDCOperationErase
+	case raw.Update != nil || raw.NewImage != nil:
+		res.Operation = CDCOperationUpdate
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errCDCUnexpectedOperation, data))
+	}
+
+	if raw.Key == nil {
+		return nil, xerrors.WithStackTrace(errCDCEventWithoutKey)
+	}
+	if err := unmarshalCDCKey(raw.Key, &res.Key); err != nil {
+		return nil, err
+	}
+
+	var err error
+	// update is empty object for keys only and images modes
+	if !isEmptyJSONObject(raw.Update) {
+		if res.Update, err = unmarshalCDCImage[V](raw.Update); err != nil {
+			return nil, err
+		}
+	}
+	if res.NewImage, err = unmarshalCDCImage[V](raw.NewImage); err != nil {
+		return nil, err
+	}
+	if res.OldImage, err = unmarshalCDCImage[V](raw.OldImage); err != nil {
+		return nil, err
+	}
+
+	if raw.TS != nil {
+		if res.Timestamp, err = newCDCTimestamp(raw.TS); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func newCDCTimestamp(vals []uint64) (CDCTimestamp, error) {
+	if len(vals) != 2 { //nolint:gomnd
+		return CDCTimestamp{}, xerrors.WithStackTrace(fmt.Errorf("%w: %v", errCDCBadTimestamp, vals))
+	}
+
+	return CDCTimestamp{Step: vals[0], TxID: vals[1]}, nil
+}
+
+func unmarshalCDCImage[V any](data json.RawMessage) (*V, error) {
+	if data == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	var res V
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: failed to unmarshal cdc row image: %w", err))
+	}
+
+	return &res, nil
+}
+
+func isEmptyJSONObject(data json.RawMessage) bool {
+	if data == nil {
+		return true
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return false
+	}
+
+	return len(obj) == 0
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+func unmarshalCDCKey(data json.RawMessage, dst interface{}) error {
+	dstVal := reflect.ValueOf(dst).Elem()
+
+	if reflect.PointerTo(dstVal.Type()).Implements(jsonUnmarshalerType) {
+		return unmarshalCDCKeyJSON(data, dst)
+	}
+
+	switch dstVal.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Interface:
+		return unmarshalCDCKeyJSON(data, dst)
+	case reflect.Struct:
+		return unmarshalCDCKeyToStruct(data, dstVal)
+	default:
+		var columns []json.RawMessage
+		if err := unmarshalCDCKeyJSON(data, &columns); err != nil {
+			return err
+		}
+		if len(columns) != 1 {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: %v key columns to %v", errCDCKeyColumnsCountDiffs,
+				len(columns), dstVal.Type()))
+		}
+
+		return unmarshalCDCKeyJSON(columns[0], dst)
+	}
+}
+
+func unmarshalCDCKeyToStruct(data json.RawMessage, dstVal reflect.Value) error {
+	var columns []json.RawMessage
+	if err := unmarshalCDCKeyJSON(data, &columns); err != nil {
+		return err
+	}
+
+	dstType := dstVal.Type()
+	columnIndex := 0
+	for i := 0; i < dstType.NumField(); i++ {
+		if !dstType.Field(i).IsExported() {
+			continue
+		}
+		if columnIndex >= len(columns) {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: %v key columns to %v", errCDCKeyColumnsCountDiffs,
+				len(columns), dstType))
+		}
+		if err := unmarshalCDCKeyJSON(columns[columnIndex], dstVal.Field(i).Addr().Interface()); err != nil {
+			return err
+		}
+		columnIndex++
+	}
+
+	if columnIndex != len(columns) {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %v key columns to %v", errCDCKeyColumnsCountDiffs,
+			len(columns), dstType))
+	}
+
+	return nil
+}
+
+func unmarshalCDCKeyJSON(data json.RawMessage, dst interface{}) error {
+	if err := json.Unmarshal(data, dst); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: failed to unmarshal cdc key: %w", err))
+	}
+
+	return nil
+}
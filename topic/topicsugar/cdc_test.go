@@ -0,0 +1,124 @@
+package topicsugar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+)
+
+type testCDCKey struct {
+	ID   uint64
+	Name string
+}
+
+type testCDCRow struct {
+	Volume int64  `json:"volume"`
+	Title  string `json:"title"`
+}
+
+func TestParseCDCEvent(t *testing.T) {
+	t.Run("KeysOnly", func(t *testing.T) {
+		event, err := ParseCDCEvent[testCDCKey, testCDCRow]([]byte(`{"key":[1,"one"],"update":{}}`))
+		require.NoError(t, err)
+		require.Equal(t, &CDCEvent[testCDCKey, testCDCRow]{
+			Operation: CDCOperationUpdate,
+			Key:       testCDCKey{ID: 1, Name: "one"},
+		}, event)
+
+		event, err = ParseCDCEvent[testCDCKey, testCDCRow]([]byte(`{"key":[2,"two"],"erase":{}}`))
+		require.NoError(t, err)
+		require.Equal(t, &CDCEvent[testCDCKey, testCDCRow]{
+			Operation: CDCOperationErase,
+			Key:       testCDCKey{ID: 2, Name: "two"},
+		}, event)
+	})
+	t.Run("Updates", func(t *testing.T) {
+		event, err := ParseCDCEvent[testCDCKey, testCDCRow](
+			[]byte(`{"key":[1,"one"],"update":{"volume":10},"ts":[1670792400890,562949953607163]}`),
+		)
+		require.NoError(t, err)
+		require.Equal(t, &CDCEvent[testCDCKey, testCDCRow]{
+			Operation: CDCOperationUpdate,
+			Key:       testCDCKey{ID: 1, Name: "one"},
+			Update:    &testCDCRow{Volume: 10},
+			Timestamp: CDCTimestamp{Step: 1670792400890, TxID: 562949953607163},
+		}, event)
+	})
+	t.Run("NewAndOldImages", func(t *testing.T) {
+		event, err := ParseCDCEvent[testCDCKey, testCDCRow]([]byte(
+			`{"key":[1,"one"],"update":{},"newImage":{"volume":10,"title":"new"},"oldImage":{"volume":5,"title":"old"}}`,
+		))
+		require.NoError(t, err)
+		require.Equal(t, &CDCEvent[testCDCKey, testCDCRow]{
+			Operation: CDCOperationUpdate,
+			Key:       testCDCKey{ID: 1, Name: "one"},
+			NewImage:  &testCDCRow{Volume: 10, Title: "new"},
+			OldImage:  &testCDCRow{Volume: 5, Title: "old"},
+		}, event)
+
+		event, err = ParseCDCEvent[testCDCKey, testCDCRow]([]byte(
+			`{"key":[1,"one"],"erase":{},"oldImage":{"volume":5,"title":"old"}}`,
+		))
+		require.NoError(t, err)
+		require.Equal(t, &CDCEvent[testCDCKey, testCDCRow]{
+			Operation: CDCOperationErase,
+			Key:       testCDCKey{ID: 1, Name: "one"},
+			OldImage:  &testCDCRow{Volume: 5, Title: "old"},
+		}, event)
+	})
+	t.Run("NewImageWithoutUpdate", func(t *testing.T) {
+		event, err := ParseCDCEvent[testCDCKey, testCDCRow]([]byte(`{"key":[1,"one"],"newImage":{"volume":10}}`))
+		require.NoError(t, err)
+		require.Equal(t, CDCOperationUpdate, event.Operation)
+		require.Equal(t, &testCDCRow{Volume: 10}, event.NewImage)
+	})
+	t.Run("ResolvedTimestamp", func(t *testing.T) {
+		event, err := ParseCDCEvent[testCDCKey, testCDCRow]([]byte(`{"resolved":[1670792400890,18446744073709551615]}`))
+		require.NoError(t, err)
+		require.Equal(t, &CDCEvent[testCDCKey, testCDCRow]{
+			Operation: CDCOperationResolvedTimestamp,
+			Timestamp: CDCTimestamp{Step: 1670792400890, TxID: 18446744073709551615},
+		}, event)
+	})
+	t.Run("KeyTypes", func(t *testing.T) {
+		single, err := ParseCDCEvent[uint64, testCDCRow]([]byte(`{"key":[5],"erase":{}}`))
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), single.Key)
+
+		slice, err := ParseCDCEvent[[]interface{}, testCDCRow]([]byte(`{"key":[5,"five"],"erase":{}}`))
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{float64(5), "five"}, slice.Key)
+
+		_, err = ParseCDCEvent[uint64, testCDCRow]([]byte(`{"key":[5,"five"],"erase":{}}`))
+		require.ErrorIs(t, err, errCDCKeyColumnsCountDiffs)
+
+		_, err = ParseCDCEvent[testCDCKey, testCDCRow]([]byte(`{"key":[5],"erase":{}}`))
+		require.ErrorIs(t, err, errCDCKeyColumnsCountDiffs)
+	})
+	t.Run("BadRecords", func(t *testing.T) {
+		_, err := ParseCDCEvent[uint64, testCDCRow]([]byte(`{"key":[5]}`))
+		require.ErrorIs(t, err, errCDCUnexpectedOperation)
+
+		_, err = ParseCDCEvent[uint64, testCDCRow]([]byte(`{"erase":{}}`))
+		require.ErrorIs(t, err, errCDCEventWithoutKey)
+
+		_, err = ParseCDCEvent[uint64, testCDCRow]([]byte(`{"resolved":[1]}`))
+		require.ErrorIs(t, err, errCDCBadTimestamp)
+
+		_, err = ParseCDCEvent[uint64, testCDCRow]([]byte(`not json`))
+		require.Error(t, err)
+	})
+}
+
+func TestUnmarshalCDCEvent(t *testing.T) {
+	msg := testutil.NewTopicReaderMessageBuilder().
+		DataAndUncompressedSize([]byte(`{"key":[1,"one"],"update":{"volume":10}}`)).
+		Build()
+
+	event, err := UnmarshalCDCEvent[testCDCKey, testCDCRow](msg)
+	require.NoError(t, err)
+	require.Equal(t, testCDCKey{ID: 1, Name: "one"}, event.Key)
+	require.Equal(t, &testCDCRow{Volume: 10}, event.Update)
+}
@@ -0,0 +1,83 @@
+package topicsugar
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Codec serialize values of type T to message content and back
+type Codec[T any] interface {
+	Marshal(v T) ([]byte, error)
+	Unmarshal(data []byte, dst *T) error
+}
+
+// JSONCodec serialize values with encoding/json
+type JSONCodec[T any] struct{}
+
+// Marshal implement Codec
+func (JSONCodec[T]) Marshal(v T) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return data, nil
+}
+
+// Unmarshal implement Codec
+func (JSONCodec[T]) Unmarshal(data []byte, dst *T) error {
+	return xerrors.WithStackTrace(json.Unmarshal(data, dst))
+}
+
+// ProtoCodec serialize protobuf messages, T must be pointer to generated message struct, for example *pb.MyMessage
+type ProtoCodec[T proto.Message] struct{}
+
+// Marshal implement Codec
+func (ProtoCodec[T]) Marshal(v T) ([]byte, error) {
+	data, err := proto.Marshal(v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return data, nil
+}
+
+// Unmarshal implement Codec
+func (ProtoCodec[T]) Unmarshal(data []byte, dst *T) error {
+	var zero T
+	v, ok := zero.ProtoReflect().New().Interface().(T)
+	if !ok {
+		// must be never for generated messages
+		return xerrors.WithStackTrace(errBadProtoCodecType)
+	}
+	if err := proto.Unmarshal(data, v); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	*dst = v
+
+	return nil
+}
+
+// GobCodec serialize values with encoding/gob.
+// Every message contains full type description, prefer JSONCodec or ProtoCodec for small messages.
+type GobCodec[T any] struct{}
+
+// Marshal implement Codec
+func (GobCodec[T]) Marshal(v T) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal implement Codec
+func (GobCodec[T]) Unmarshal(data []byte, dst *T) error {
+	return xerrors.WithStackTrace(gob.NewDecoder(bytes.NewReader(data)).Decode(dst))
+}
@@ -0,0 +1,254 @@
+package topicsugar
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/wait"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+// Metadata keys of messages, written to dead letter topic
+const (
+	deadLetterMetadataKeyPrefix = "ydb-dlq-"
+
+	DeadLetterErrorMetadataKey       = deadLetterMetadataKeyPrefix + "error"
+	DeadLetterSourceTopicMetadataKey = deadLetterMetadataKeyPrefix + "source-topic"
+	DeadLetterPartitionMetadataKey   = deadLetterMetadataKeyPrefix + "source-partition"
+	DeadLetterOffsetMetadataKey      = deadLetterMetadataKeyPrefix + "source-offset"
+)
+
+const (
+	defaultDeadLetterAttempts = 3
+
+	// error text truncated for keep metadata size small
+	maxDeadLetterErrorLen = 1024
+)
+
+// MessageProcessor process content of the message.
+// data is full content of the message, it is same for all attempts.
+type MessageProcessor func(ctx context.Context, msg *topicreader.Message, data []byte) error
+
+// MessageCommitter commit processed messages, for example *topicreader.Reader or *TypedReader
+type MessageCommitter interface {
+	Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error
+}
+
+type deadLetterWriter interface {
+	Write(ctx context.Context, messages ...topicwriter.Message) error
+	Flush(ctx context.Context) error
+}
+
+// DeadLetterQueue move messages, which can't be processed, to dead letter topic
+type DeadLetterQueue struct {
+	writer   deadLetterWriter
+	attempts int
+	backoff  backoff.Backoff
+}
+
+// DeadLetterOption is option for NewDeadLetterQueue
+type DeadLetterOption func(q *DeadLetterQueue)
+
+// WithDeadLetterAttempts set count of process attempts before move the message to dead letter topic, default 3
+func WithDeadLetterAttempts(attempts int) DeadLetterOption {
+	return func(q *DeadLetterQueue) {
+		if attempts > 0 {
+			q.attempts = attempts
+		}
+	}
+}
+
+// WithDeadLetterBackoff set backoff between process attempts, default is fast backoff of retry package.
+// Use retry.Backoff(slotDuration, ceiling, jitterLimit) for create custom backoff
+// or any type with method Delay(attempt int) time.Duration.
+func WithDeadLetterBackoff(b backoff.Backoff) DeadLetterOption {
+	return func(q *DeadLetterQueue) {
+		if b != nil {
+			q.backoff = b
+		}
+	}
+}
+
+// NewDeadLetterQueue create dead letter queue, which write messages to the writer of dead letter topic
+func NewDeadLetterQueue(writer *topicwriter.Writer, opts ...DeadLetterOption) *DeadLetterQueue {
+	return newDeadLetterQueue(writer, opts...)
+}
+
+func newDeadLetterQueue(writer deadLetterWriter, opts ...DeadLetterOption) *DeadLetterQueue {
+	q := &DeadLetterQueue{
+		writer:   writer,
+		attempts: defaultDeadLetterAttempts,
+		backoff:  backoff.Fast,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(q)
+		}
+	}
+
+	return q
+}
+
+// Process call f for the message until success, but not more than attempts count (see WithDeadLetterAttempts).
+// If all attempts failed - the message written to dead letter topic with original content and metadata,
+// process error, source topic, partition and offset in metadata (see DeadLetter*MetadataKey).
+//
+// The message is committed by committer after success processing or after it acknowledged by dead letter topic.
+// Process return error only if ctx cancelled, write to dead letter topic or commit failed.
+func (q *DeadLetterQueue) Process(
+	ctx context.Context,
+	committer MessageCommitter,
+	msg *topicreader.Message,
+	f MessageProcessor,
+) error {
+	var data []byte
+	err := ReadMessageDataWithCallback(msg, func(content []byte) error {
+		data = bytes.Clone(content)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var processErr error
+	for attempt := 0; attempt < q.attempts; attempt++ {
+		if attempt > 0 {
+			if err = wait.Wait(ctx, q.backoff, nil, backoff.TypeFast, attempt-1); err != nil {
+				return xerrors.WithStackTrace(fmt.Errorf("ydb: message processing interrupted: %w (last error: %w)",
+					err, processErr))
+			}
+		}
+
+		processErr = f(ctx, msg, data)
+		if processErr == nil {
+			return committer.Commit(ctx, msg)
+		}
+	}
+
+	if err = ctx.Err(); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: message processing interrupted: %w (last error: %w)",
+			err, processErr))
+	}
+
+	if err = q.writeDeadLetter(ctx, msg, data, processErr); err != nil {
+		return err
+	}
+
+	return committer.Commit(ctx, msg)
+}
+
+func (q *DeadLetterQueue) writeDeadLetter(
+	ctx context.Context,
+	msg *topicreader.Message,
+	data []byte,
+	processErr error,
+) error {
+	errText := truncateUTF8(processErr.Error(), maxDeadLetterErrorLen)
+
+	metadata := make(map[string][]byte, len(msg.Metadata)+4) //nolint:gomnd
+	for k, v := range msg.Metadata {
+		metadata[k] = v
+	}
+	metadata[DeadLetterErrorMetadataKey] = []byte(errText)
+	metadata[DeadLetterSourceTopicMetadataKey] = []byte(msg.Topic())
+	metadata[DeadLetterPartitionMetadataKey] = []byte(strconv.FormatInt(msg.PartitionID(), 10))
+	metadata[DeadLetterOffsetMetadataKey] = []byte(strconv.FormatInt(msg.Offset, 10))
+
+	err := q.writer.Write(ctx, topicwriter.Message{
+		CreatedAt: msg.CreatedAt,
+		Data:      bytes.NewReader(data),
+		Metadata:  metadata,
+	})
+	if err == nil {
+		err = q.writer.Flush(ctx)
+	}
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: failed to write message to dead letter topic: %w", err))
+	}
+
+	return nil
+}
+
+type deadLetterReplaySource interface {
+	MessageCommitter
+	ReadMessagesBatch(ctx context.Context, opts ...topicreader.ReadBatchOption) (*topicreader.Batch, error)
+}
+
+// ReplayDeadLetters move messages from dead letter topic back to the source topic.
+// reader must read dead letter topic and writer must write to the source topic.
+//
+// Messages are written with original content and metadata, dead letter metadata removed.
+// The function works until ctx cancelled or first error, it commits dead letters after they
+// acknowledged by the source topic.
+func ReplayDeadLetters(ctx context.Context, reader *topicreader.Reader, writer *topicwriter.Writer) error {
+	return replayDeadLetters(ctx, reader, writer)
+}
+
+func replayDeadLetters(ctx context.Context, reader deadLetterReplaySource, writer deadLetterWriter) error {
+	for {
+		batch, err := reader.ReadMessagesBatch(ctx)
+		if err != nil {
+			return err
+		}
+
+		messages := make([]topicwriter.Message, 0, len(batch.Messages))
+		for _, msg := range batch.Messages {
+			var data []byte
+			err = ReadMessageDataWithCallback(msg, func(content []byte) error {
+				data = bytes.Clone(content)
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			var metadata map[string][]byte
+			for k, v := range msg.Metadata {
+				if strings.HasPrefix(k, deadLetterMetadataKeyPrefix) {
+					continue
+				}
+				if metadata == nil {
+					metadata = make(map[string][]byte, len(msg.Metadata))
+				}
+				metadata[k] = v
+			}
+
+			messages = append(messages, topicwriter.Message{
+				CreatedAt: msg.CreatedAt,
+				Data:      bytes.NewReader(data),
+				Metadata:  metadata,
+			})
+		}
+
+		if err = writer.Write(ctx, messages...); err != nil {
+			return err
+		}
+		if err = writer.Flush(ctx); err != nil {
+			return err
+		}
+		if err = reader.Commit(ctx, batch); err != nil {
+			return err
+		}
+	}
+}
+
+// truncateUTF8 cut the string to maxLen bytes without split of multibyte characters
+func truncateUTF8(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	for maxLen > 0 && !utf8.RuneStart(s[maxLen]) {
+		maxLen--
+	}
+
+	return s[:maxLen]
+}
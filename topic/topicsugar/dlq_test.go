@@ -0,0 +1,192 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+func TestDeadLetterQueueProcess(t *testing.T) {
+	ctx := context.Background()
+	noBackoff := backoff.New(backoff.WithSlotDuration(time.Nanosecond))
+	newMessage := func() *topicreader.Message {
+		builder := testutil.NewTopicReaderMessageBuilder().
+			DataAndUncompressedSize([]byte("content")).
+			Metadata(map[string][]byte{"key": []byte("val")}).
+			Offset(10)
+		builder.Topic("source")
+		builder.PartitionID(2)
+
+		return builder.Build()
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		writer := &testDeadLetterWriter{}
+		committer := &testTypedReaderSource{}
+		q := newDeadLetterQueue(writer, WithDeadLetterBackoff(noBackoff))
+		msg := newMessage()
+
+		attempts := 0
+		err := q.Process(ctx, committer, msg, func(ctx context.Context, msg *topicreader.Message, data []byte) error {
+			attempts++
+			require.Equal(t, "content", string(data))
+			if attempts < 2 {
+				return errors.New("test")
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, attempts)
+		require.Empty(t, writer.messages)
+		require.Equal(t, []topicreader.CommitRangeGetter{msg}, committer.committed)
+	})
+	t.Run("MoveToDeadLetters", func(t *testing.T) {
+		writer := &testDeadLetterWriter{}
+		committer := &testTypedReaderSource{}
+		q := newDeadLetterQueue(writer, WithDeadLetterAttempts(4), WithDeadLetterBackoff(noBackoff))
+		msg := newMessage()
+
+		attempts := 0
+		err := q.Process(ctx, committer, msg, func(ctx context.Context, msg *topicreader.Message, data []byte) error {
+			attempts++
+			require.Equal(t, "content", string(data))
+
+			return errors.New("test")
+		})
+		require.NoError(t, err)
+		require.Equal(t, 4, attempts)
+		require.Equal(t, 1, writer.flushes)
+		require.Len(t, writer.messages, 1)
+		require.Equal(t, "content", writer.data[0])
+		require.Equal(t, map[string][]byte{
+			"key":                            []byte("val"),
+			DeadLetterErrorMetadataKey:       []byte("test"),
+			DeadLetterSourceTopicMetadataKey: []byte("source"),
+			DeadLetterPartitionMetadataKey:   []byte("2"),
+			DeadLetterOffsetMetadataKey:      []byte("10"),
+		}, writer.messages[0].Metadata)
+		require.Equal(t, []topicreader.CommitRangeGetter{msg}, committer.committed)
+	})
+	t.Run("WriteFailed", func(t *testing.T) {
+		testErr := errors.New("write")
+		writer := &testDeadLetterWriter{err: testErr}
+		committer := &testTypedReaderSource{}
+		q := newDeadLetterQueue(writer, WithDeadLetterAttempts(1))
+
+		err := q.Process(ctx, committer, newMessage(), func(ctx context.Context, msg *topicreader.Message, data []byte) error {
+			return errors.New("test")
+		})
+		require.ErrorIs(t, err, testErr)
+		require.Empty(t, committer.committed)
+	})
+	t.Run("ContextCancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		writer := &testDeadLetterWriter{}
+		committer := &testTypedReaderSource{}
+		q := newDeadLetterQueue(writer, WithDeadLetterBackoff(noBackoff))
+
+		processErr := errors.New("test")
+		err := q.Process(ctx, committer, newMessage(), func(ctx context.Context, msg *topicreader.Message, data []byte) error {
+			cancel()
+
+			return processErr
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		require.ErrorIs(t, err, processErr)
+		require.Empty(t, writer.messages)
+		require.Empty(t, committer.committed)
+	})
+}
+
+func TestReplayDeadLetters(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	msg := testutil.NewTopicReaderMessageBuilder().
+		DataAndUncompressedSize([]byte("content")).
+		Metadata(map[string][]byte{
+			"key":                      []byte("val"),
+			DeadLetterErrorMetadataKey: []byte("test"),
+		}).
+		Build()
+	batch := &topicreader.Batch{Messages: []*topicreader.Message{msg}}
+
+	source := &testDeadLetterReplaySource{batches: []*topicreader.Batch{batch}}
+	writer := &testDeadLetterWriter{}
+	err := replayDeadLetters(ctx, source, writer)
+	require.ErrorIs(t, err, io.EOF)
+
+	require.Equal(t, []string{"content"}, writer.data)
+	require.Equal(t, map[string][]byte{"key": []byte("val")}, writer.messages[0].Metadata)
+	require.Equal(t, 1, writer.flushes)
+	require.Equal(t, []topicreader.CommitRangeGetter{batch}, source.committed)
+}
+
+type testDeadLetterWriter struct {
+	err      error
+	messages []topicwriter.Message
+	data     []string
+	flushes  int
+}
+
+func (w *testDeadLetterWriter) Write(ctx context.Context, messages ...topicwriter.Message) error {
+	if w.err != nil {
+		return w.err
+	}
+	for _, msg := range messages {
+		data, err := io.ReadAll(msg.Data)
+		if err != nil {
+			return err
+		}
+		w.messages = append(w.messages, msg)
+		w.data = append(w.data, string(data))
+	}
+
+	return nil
+}
+
+func (w *testDeadLetterWriter) Flush(ctx context.Context) error {
+	w.flushes++
+
+	return w.err
+}
+
+type testDeadLetterReplaySource struct {
+	testTypedReaderSource
+
+	batches []*topicreader.Batch
+}
+
+func (s *testDeadLetterReplaySource) ReadMessagesBatch(
+	ctx context.Context,
+	opts ...topicreader.ReadBatchOption,
+) (*topicreader.Batch, error) {
+	if len(s.batches) == 0 {
+		return nil, io.EOF
+	}
+	batch := s.batches[0]
+	s.batches = s.batches[1:]
+
+	return batch, nil
+}
+
+func TestTruncateUTF8(t *testing.T) {
+	require.Equal(t, "abc", truncateUTF8("abc", 5))
+	require.Equal(t, "ab", truncateUTF8("abc", 2))
+	// "я" is two bytes, cut of the second byte skips whole character
+	require.Equal(t, "a", truncateUTF8("aяb", 2))
+	require.Equal(t, "aя", truncateUTF8("aяb", 3))
+	require.True(t, utf8.ValidString(truncateUTF8(strings.Repeat("я", 1000), maxDeadLetterErrorLen+1)))
+}
@@ -0,0 +1,269 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+// ErrOffsetAlreadyStored returned from commit methods of TableOffsetStore if stored offset of the partition
+// greater than start offset of committed messages.
+// It means the messages already processed, for example by other reader after partition rebalance.
+// Transaction with the commit must be rolled back.
+var ErrOffsetAlreadyStored = xerrors.Wrap(errors.New("ydb: topic offset already stored"))
+
+// TableOffsetStore keep read progress (consumer, topic, partition) -> offset in YDB table.
+//
+// Commit methods store the offset in same transaction as business writes, that give
+// exactly once effects of messages processing without server side commits.
+// The reader must be created with ReaderOptions for start partitions from stored offsets.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type TableOffsetStore struct {
+	db        table.Client
+	tablePath string
+	consumer  string
+}
+
+// NewTableOffsetStore create offset store in table tablePath (absolute path) for the consumer.
+// Consumer name is key for separate progress of independent readers in one table, it may not be same
+// as name of topic consumer.
+func NewTableOffsetStore(db table.Client, tablePath, consumer string) *TableOffsetStore {
+	return &TableOffsetStore{
+		db:        db,
+		tablePath: tablePath,
+		consumer:  consumer,
+	}
+}
+
+// CreateTable create table for the store if it not exists
+func (s *TableOffsetStore) CreateTable(ctx context.Context) error {
+	return s.db.Do(ctx, func(ctx context.Context, session table.Session) error {
+		return session.ExecuteSchemeQuery(ctx, fmt.Sprintf(`
+			CREATE TABLE IF NOT EXISTS %s (
+				consumer Text NOT NULL,
+				topic Text NOT NULL,
+				partition_id Int64 NOT NULL,
+				read_offset Int64 NOT NULL,
+				PRIMARY KEY (consumer, topic, partition_id)
+			)`, s.quotedTablePath()),
+		)
+	}, table.WithIdempotent())
+}
+
+// ReaderOptions return options for topic reader: disable server side commits and start
+// partitions from stored offsets.
+func (s *TableOffsetStore) ReaderOptions() []topicoptions.ReaderOption {
+	return []topicoptions.ReaderOption{
+		topicoptions.WithReaderCommitMode(topicoptions.CommitModeNone),
+		topicoptions.WithReaderGetPartitionStartOffset(s.GetPartitionStartOffset),
+	}
+}
+
+// GetPartitionStartOffset implement topicoptions.GetPartitionStartOffsetFunc.
+// Partition without stored offset starts from server committed offset.
+func (s *TableOffsetStore) GetPartitionStartOffset(
+	ctx context.Context,
+	req topicoptions.GetPartitionStartOffsetRequest,
+) (res topicoptions.GetPartitionStartOffsetResponse, err error) {
+	var (
+		offset int64
+		found  bool
+	)
+	err = s.db.Do(ctx, func(ctx context.Context, session table.Session) error {
+		_, r, err := session.Execute(ctx, table.OnlineReadOnlyTxControl(), s.selectQuery(),
+			s.keyParams(req.Topic, req.PartitionID).Build(),
+		)
+		if err != nil {
+			return err
+		}
+		offset, found, err = scanTableOffset(ctx, r)
+
+		return err
+	}, table.WithIdempotent())
+	if err != nil {
+		return res, xerrors.WithStackTrace(fmt.Errorf("ydb: failed to read stored topic offset: %w", err))
+	}
+
+	if found {
+		res.StartFrom(offset)
+	}
+
+	return res, nil
+}
+
+// CommitInTableTx store end offset of the message or batch in the table transaction.
+// It returns ErrOffsetAlreadyStored if the messages already processed.
+func (s *TableOffsetStore) CommitInTableTx(
+	ctx context.Context,
+	tx table.TransactionActor,
+	obj topicreader.CommitRangeGetter,
+) error {
+	commitRange := topicreader.GetCommitRange(obj)
+
+	r, err := tx.Execute(ctx, s.selectQuery(),
+		s.keyParams(commitRange.Topic(), commitRange.PartitionID()).Build(),
+	)
+	if err != nil {
+		return err
+	}
+	stored, found, err := scanTableOffset(ctx, r)
+	if err != nil {
+		return err
+	}
+	if err = checkStoredOffset(commitRange, stored, found); err != nil {
+		return err
+	}
+
+	r, err = tx.Execute(ctx, s.upsertQuery(), s.upsertParams(commitRange))
+	if err != nil {
+		return err
+	}
+
+	return r.Close()
+}
+
+// CommitInQueryTx store end offset of the message or batch in the query service transaction.
+// It returns ErrOffsetAlreadyStored if the messages already processed.
+func (s *TableOffsetStore) CommitInQueryTx(
+	ctx context.Context,
+	tx query.TxActor,
+	obj topicreader.CommitRangeGetter,
+) error {
+	commitRange := topicreader.GetCommitRange(obj)
+
+	r, err := tx.Execute(ctx, s.selectQuery(),
+		query.WithParameters(s.keyParams(commitRange.Topic(), commitRange.PartitionID()).Build()),
+	)
+	if err != nil {
+		return err
+	}
+	stored, found, err := scanQueryOffset(ctx, r)
+	if err != nil {
+		return err
+	}
+	if err = checkStoredOffset(commitRange, stored, found); err != nil {
+		return err
+	}
+
+	r, err = tx.Execute(ctx, s.upsertQuery(), query.WithParameters(s.upsertParams(commitRange)))
+	if err != nil {
+		return err
+	}
+
+	return r.Close(ctx)
+}
+
+func (s *TableOffsetStore) quotedTablePath() string {
+	return "`" + s.tablePath + "`"
+}
+
+func (s *TableOffsetStore) selectQuery() string {
+	return fmt.Sprintf(`
+		DECLARE $consumer AS Text;
+		DECLARE $topic AS Text;
+		DECLARE $partition_id AS Int64;
+
+		SELECT read_offset FROM %s
+		WHERE consumer = $consumer AND topic = $topic AND partition_id = $partition_id;
+	`, s.quotedTablePath())
+}
+
+func (s *TableOffsetStore) upsertQuery() string {
+	return fmt.Sprintf(`
+		DECLARE $consumer AS Text;
+		DECLARE $topic AS Text;
+		DECLARE $partition_id AS Int64;
+		DECLARE $read_offset AS Int64;
+
+		UPSERT INTO %s (consumer, topic, partition_id, read_offset)
+		VALUES ($consumer, $topic, $partition_id, $read_offset);
+	`, s.quotedTablePath())
+}
+
+func (s *TableOffsetStore) keyParams(topic string, partitionID int64) params.Builder {
+	return params.Builder{}.
+		Param("$consumer").Text(s.consumer).
+		Param("$topic").Text(topic).
+		Param("$partition_id").Int64(partitionID)
+}
+
+func (s *TableOffsetStore) upsertParams(commitRange topicreader.CommitRange) *params.Parameters {
+	return s.keyParams(commitRange.Topic(), commitRange.PartitionID()).
+		Param("$read_offset").Int64(commitRange.EndOffset()).
+		Build()
+}
+
+func checkStoredOffset(commitRange topicreader.CommitRange, stored int64, found bool) error {
+	if found && stored > commitRange.StartOffset() {
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"%w: topic %q, partition %v, stored offset %v, commit range [%v, %v)",
+			ErrOffsetAlreadyStored, commitRange.Topic(), commitRange.PartitionID(),
+			stored, commitRange.StartOffset(), commitRange.EndOffset(),
+		))
+	}
+
+	return nil
+}
+
+func scanTableOffset(ctx context.Context, r result.Result) (offset int64, found bool, err error) {
+	defer func() {
+		_ = r.Close()
+	}()
+
+	for r.NextResultSet(ctx) {
+		for r.NextRow() {
+			if err = r.ScanNamed(named.Required("read_offset", &offset)); err != nil {
+				return 0, false, err
+			}
+			found = true
+		}
+	}
+
+	return offset, found, r.Err()
+}
+
+func scanQueryOffset(ctx context.Context, r query.Result) (offset int64, found bool, err error) {
+	defer func() {
+		_ = r.Close(ctx)
+	}()
+
+	for {
+		rs, err := r.NextResultSet(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return 0, false, err
+		}
+		for {
+			row, err := rs.NextRow(ctx)
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+
+				return 0, false, err
+			}
+			if err = row.ScanNamed(query.Named("read_offset", &offset)); err != nil {
+				return 0, false, err
+			}
+			found = true
+		}
+	}
+
+	return offset, found, r.Err()
+}
@@ -0,0 +1,27 @@
+package topicsugar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+func TestCheckStoredOffset(t *testing.T) {
+	builder := testutil.NewTopicReaderMessageBuilder().Offset(10)
+	builder.Topic("topic")
+	builder.PartitionID(1)
+	commitRange := topicreader.GetCommitRange(builder.Build())
+
+	require.Equal(t, "topic", commitRange.Topic())
+	require.Equal(t, int64(1), commitRange.PartitionID())
+	require.Equal(t, int64(10), commitRange.StartOffset())
+	require.Equal(t, int64(11), commitRange.EndOffset())
+
+	require.NoError(t, checkStoredOffset(commitRange, 0, false))
+	require.NoError(t, checkStoredOffset(commitRange, 5, true))
+	require.NoError(t, checkStoredOffset(commitRange, 10, true))
+	require.ErrorIs(t, checkStoredOffset(commitRange, 11, true), ErrOffsetAlreadyStored)
+}
@@ -0,0 +1,325 @@
+package topicsugar
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+// SchemaVersionMetadataKey is key of message metadata with schema version of the message content
+const SchemaVersionMetadataKey = "ydb-schema-version"
+
+var (
+	errBadProtoCodecType          = xerrors.Wrap(errors.New("ydb: proto codec type can't create new message"))
+	errUnexpectedSchemaVersion    = xerrors.Wrap(errors.New("ydb: unexpected schema version of topic message"))
+	errTypedReaderPoisonedMessage = xerrors.Wrap(errors.New("ydb: typed reader failed to decode message"))
+)
+
+// PoisonMessageHandler called for messages, which can't be decoded by typed reader.
+//
+// If the handler return nil - the message will be skipped and committed, reader continue read next messages.
+// The message is not committed if commits disabled in the reader (topicoptions.CommitModeNone).
+// If the handler return error - read method return the error.
+//
+// Sync commit mode (topicoptions.CommitModeSync) require commits in order of messages, skipped message
+// committed while read, so all previous messages must be committed before next read call. Else read
+// method return error of commit with wrong order. Async commit mode has no the restriction.
+type PoisonMessageHandler func(ctx context.Context, msg *topicreader.Message, decodeErr error) error
+
+// TypedWriter write values of type T to topic with codec
+type TypedWriter[T any] struct {
+	writer        *topicwriter.Writer
+	codec         Codec[T]
+	schemaVersion string
+}
+
+// TypedWriterOption is option for NewTypedWriter
+type TypedWriterOption func(cfg *typedWriterConfig)
+
+type typedWriterConfig struct {
+	schemaVersion string
+}
+
+// WithWriterSchemaVersion set schema version, which will be saved to metadata
+// of every message with key SchemaVersionMetadataKey
+func WithWriterSchemaVersion(version string) TypedWriterOption {
+	return func(cfg *typedWriterConfig) {
+		cfg.schemaVersion = version
+	}
+}
+
+// NewTypedWriter create typed writer over the writer
+func NewTypedWriter[T any](writer *topicwriter.Writer, codec Codec[T], opts ...TypedWriterOption) *TypedWriter[T] {
+	cfg := typedWriterConfig{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+
+	return &TypedWriter[T]{
+		writer:        writer,
+		codec:         codec,
+		schemaVersion: cfg.schemaVersion,
+	}
+}
+
+// Write serialize values and write them to topic, see topicwriter.Writer.Write for details
+func (w *TypedWriter[T]) Write(ctx context.Context, values ...T) error {
+	messages, err := w.messages(values)
+	if err != nil {
+		return err
+	}
+
+	return w.writer.Write(ctx, messages...)
+}
+
+// WriteWithAck serialize values and write them to topic, see topicwriter.Writer.WriteWithAck for details
+func (w *TypedWriter[T]) WriteWithAck(ctx context.Context, values ...T) (*topicwriter.WriteAckFuture, error) {
+	messages, err := w.messages(values)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.writer.WriteWithAck(ctx, messages...)
+}
+
+// Flush wait acks for all written messages, see topicwriter.Writer.Flush for details
+func (w *TypedWriter[T]) Flush(ctx context.Context) error {
+	return w.writer.Flush(ctx)
+}
+
+// Close the underlying writer
+func (w *TypedWriter[T]) Close(ctx context.Context) error {
+	return w.writer.Close(ctx)
+}
+
+func (w *TypedWriter[T]) messages(values []T) ([]topicwriter.Message, error) {
+	messages := make([]topicwriter.Message, len(values))
+	for i := range values {
+		data, err := w.codec.Marshal(values[i])
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: typed writer failed to encode message: %w", err))
+		}
+		messages[i].Data = bytes.NewReader(data)
+		if w.schemaVersion != "" {
+			messages[i].Metadata = map[string][]byte{SchemaVersionMetadataKey: []byte(w.schemaVersion)}
+		}
+	}
+
+	return messages, nil
+}
+
+// TypedMessage is decoded message.
+// It can be committed with TypedReader.Commit as usual message.
+type TypedMessage[T any] struct {
+	*topicreader.Message
+
+	Value T
+
+	// SchemaVersion from message metadata, empty if the message has no the metadata
+	SchemaVersion string
+}
+
+// TypedBatch is decoded batch of messages.
+// Messages contains decoded messages only, commit of the batch commit poisoned messages too.
+type TypedBatch[T any] struct {
+	*topicreader.Batch
+
+	Messages []*TypedMessage[T]
+}
+
+// TypedReaderOption is option for NewTypedReader
+type TypedReaderOption func(cfg *typedReaderConfig)
+
+type typedReaderConfig struct {
+	schemaVersions map[string]bool
+	poisonHandler  PoisonMessageHandler
+}
+
+// WithReaderSchemaVersions set allowed schema versions of messages.
+// Messages without schema version metadata are always allowed,
+// messages with other versions are handled as poisoned.
+func WithReaderSchemaVersions(versions ...string) TypedReaderOption {
+	return func(cfg *typedReaderConfig) {
+		if cfg.schemaVersions == nil {
+			cfg.schemaVersions = make(map[string]bool, len(versions))
+		}
+		for _, v := range versions {
+			cfg.schemaVersions[v] = true
+		}
+	}
+}
+
+// WithReaderPoisonMessageHandler set handler for messages, which can't be decoded.
+//
+// Without the handler read methods return decode error and the message is not committed:
+// next read call continue from next message, but the poisoned message (and other messages of the batch
+// for ReadMessagesBatch) will be read again after restart of the reader. Set the handler for skip
+// poisoned messages permanently.
+func WithReaderPoisonMessageHandler(handler PoisonMessageHandler) TypedReaderOption {
+	return func(cfg *typedReaderConfig) {
+		cfg.poisonHandler = handler
+	}
+}
+
+// typedReaderSource is subset of topicreader.Reader methods, used by typed reader
+type typedReaderSource interface {
+	ReadMessage(ctx context.Context) (*topicreader.Message, error)
+	ReadMessagesBatch(ctx context.Context, opts ...topicreader.ReadBatchOption) (*topicreader.Batch, error)
+	Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error
+	Close(ctx context.Context) error
+}
+
+// TypedReader read messages from topic and decode them to type T with codec.
+//
+// The reader commits poisoned messages, skipped by PoisonMessageHandler, use TypedReader.Commit
+// instead of Commit of underlying reader for commit messages concurrently with read.
+type TypedReader[T any] struct {
+	reader typedReaderSource
+	codec  Codec[T]
+	cfg    typedReaderConfig
+
+	commitMutex sync.Mutex
+}
+
+// NewTypedReader create typed reader over the reader
+func NewTypedReader[T any](reader *topicreader.Reader, codec Codec[T], opts ...TypedReaderOption) *TypedReader[T] {
+	return newTypedReader[T](reader, codec, opts...)
+}
+
+func newTypedReader[T any](reader typedReaderSource, codec Codec[T], opts ...TypedReaderOption) *TypedReader[T] {
+	res := &TypedReader[T]{
+		reader: reader,
+		codec:  codec,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&res.cfg)
+		}
+	}
+
+	return res
+}
+
+// ReadMessage read and decode one message, poisoned messages passed to poison handler and skipped
+func (r *TypedReader[T]) ReadMessage(ctx context.Context) (*TypedMessage[T], error) {
+	for {
+		msg, err := r.reader.ReadMessage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := r.decode(msg)
+		if err == nil {
+			return res, nil
+		}
+
+		if err = r.handlePoisonMessage(ctx, msg, err); err != nil {
+			return nil, err
+		}
+		if err = r.commitSkipped(ctx, msg); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// ReadMessagesBatch read and decode batch of messages, poisoned messages passed to poison handler and
+// excluded from TypedBatch.Messages. The method don't return batch without decoded messages.
+func (r *TypedReader[T]) ReadMessagesBatch(
+	ctx context.Context,
+	opts ...topicreader.ReadBatchOption,
+) (*TypedBatch[T], error) {
+	for {
+		batch, err := r.reader.ReadMessagesBatch(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		res := &TypedBatch[T]{
+			Batch:    batch,
+			Messages: make([]*TypedMessage[T], 0, len(batch.Messages)),
+		}
+		for _, msg := range batch.Messages {
+			typed, err := r.decode(msg)
+			if err != nil {
+				if err = r.handlePoisonMessage(ctx, msg, err); err != nil {
+					return nil, err
+				}
+
+				continue
+			}
+			res.Messages = append(res.Messages, typed)
+		}
+
+		if len(res.Messages) > 0 {
+			return res, nil
+		}
+
+		// all messages of the batch are poisoned and skipped
+		if err = r.commitSkipped(ctx, batch); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Commit message or batch, see topicreader.Reader.Commit for details
+func (r *TypedReader[T]) Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error {
+	r.commitMutex.Lock()
+	defer r.commitMutex.Unlock()
+
+	return r.reader.Commit(ctx, obj)
+}
+
+// commitSkipped commit skipped poisoned messages if commits enabled in the reader
+func (r *TypedReader[T]) commitSkipped(ctx context.Context, obj topicreader.CommitRangeGetter) error {
+	err := r.Commit(ctx, obj)
+	if errors.Is(err, topicreaderinternal.ErrCommitDisabled) {
+		return nil
+	}
+
+	return err
+}
+
+// Close the underlying reader
+func (r *TypedReader[T]) Close(ctx context.Context) error {
+	return r.reader.Close(ctx)
+}
+
+func (r *TypedReader[T]) decode(msg *topicreader.Message) (*TypedMessage[T], error) {
+	res := &TypedMessage[T]{
+		Message:       msg,
+		SchemaVersion: string(msg.Metadata[SchemaVersionMetadataKey]),
+	}
+
+	if res.SchemaVersion != "" && r.cfg.schemaVersions != nil && !r.cfg.schemaVersions[res.SchemaVersion] {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errUnexpectedSchemaVersion, res.SchemaVersion))
+	}
+
+	err := ReadMessageDataWithCallback(msg, func(data []byte) error {
+		return r.codec.Unmarshal(data, &res.Value)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *TypedReader[T]) handlePoisonMessage(ctx context.Context, msg *topicreader.Message, decodeErr error) error {
+	decodeErr = xerrors.WithStackTrace(fmt.Errorf("%w (topic %q, partition %v, offset %v): %w",
+		errTypedReaderPoisonedMessage, msg.Topic(), msg.PartitionID(), msg.Offset, decodeErr))
+
+	if r.cfg.poisonHandler == nil {
+		return decodeErr
+	}
+
+	return r.cfg.poisonHandler(ctx, msg, decodeErr)
+}
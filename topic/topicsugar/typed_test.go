@@ -0,0 +1,207 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+type testTypedValue struct {
+	ID   int
+	Name string
+}
+
+func TestCodecs(t *testing.T) {
+	t.Run("JSON", func(t *testing.T) {
+		testCodecRoundTrip[testTypedValue](t, JSONCodec[testTypedValue]{}, testTypedValue{ID: 1, Name: "a"})
+	})
+	t.Run("Gob", func(t *testing.T) {
+		testCodecRoundTrip[testTypedValue](t, GobCodec[testTypedValue]{}, testTypedValue{ID: 1, Name: "a"})
+	})
+	t.Run("Proto", func(t *testing.T) {
+		codec := ProtoCodec[*wrapperspb.StringValue]{}
+		data, err := codec.Marshal(wrapperspb.String("a"))
+		require.NoError(t, err)
+
+		var res *wrapperspb.StringValue
+		require.NoError(t, codec.Unmarshal(data, &res))
+		require.Equal(t, "a", res.GetValue())
+	})
+}
+
+func testCodecRoundTrip[T any](t *testing.T, codec Codec[T], v T) {
+	data, err := codec.Marshal(v)
+	require.NoError(t, err)
+
+	var res T
+	require.NoError(t, codec.Unmarshal(data, &res))
+	require.Equal(t, v, res)
+}
+
+func TestTypedWriterMessages(t *testing.T) {
+	w := NewTypedWriter[testTypedValue](nil, JSONCodec[testTypedValue]{}, WithWriterSchemaVersion("v2"))
+	messages, err := w.messages([]testTypedValue{{ID: 1}, {ID: 2}})
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+
+	data, err := io.ReadAll(messages[1].Data)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"ID":2,"Name":""}`, string(data))
+	require.Equal(t, map[string][]byte{SchemaVersionMetadataKey: []byte("v2")}, messages[1].Metadata)
+}
+
+func TestTypedReader(t *testing.T) {
+	ctx := context.Background()
+	newMessage := func(data string, version string) *topicreader.Message {
+		builder := testutil.NewTopicReaderMessageBuilder().DataAndUncompressedSize([]byte(data))
+		if version != "" {
+			builder.Metadata(map[string][]byte{SchemaVersionMetadataKey: []byte(version)})
+		}
+
+		return builder.Build()
+	}
+
+	t.Run("ReadMessage", func(t *testing.T) {
+		source := &testTypedReaderSource{messages: []*topicreader.Message{
+			newMessage(`{"ID":1}`, "v1"),
+		}}
+		r := newTypedReader[testTypedValue](source, JSONCodec[testTypedValue]{})
+
+		msg, err := r.ReadMessage(ctx)
+		require.NoError(t, err)
+		require.Equal(t, testTypedValue{ID: 1}, msg.Value)
+		require.Equal(t, "v1", msg.SchemaVersion)
+		require.Empty(t, source.committed)
+	})
+	t.Run("PoisonWithoutHandler", func(t *testing.T) {
+		source := &testTypedReaderSource{messages: []*topicreader.Message{
+			newMessage(`bad`, ""),
+		}}
+		r := newTypedReader[testTypedValue](source, JSONCodec[testTypedValue]{})
+
+		_, err := r.ReadMessage(ctx)
+		require.ErrorIs(t, err, errTypedReaderPoisonedMessage)
+		require.Empty(t, source.committed)
+	})
+	t.Run("PoisonSkipped", func(t *testing.T) {
+		poison := newMessage(`bad`, "")
+		otherVersion := newMessage(`{"ID":2}`, "v3")
+		source := &testTypedReaderSource{messages: []*topicreader.Message{
+			poison,
+			otherVersion,
+			newMessage(`{"ID":3}`, "v1"),
+		}}
+
+		var handled []*topicreader.Message
+		r := newTypedReader[testTypedValue](source, JSONCodec[testTypedValue]{},
+			WithReaderSchemaVersions("v1", "v2"),
+			WithReaderPoisonMessageHandler(func(ctx context.Context, msg *topicreader.Message, decodeErr error) error {
+				require.ErrorIs(t, decodeErr, errTypedReaderPoisonedMessage)
+				handled = append(handled, msg)
+
+				return nil
+			}),
+		)
+
+		msg, err := r.ReadMessage(ctx)
+		require.NoError(t, err)
+		require.Equal(t, testTypedValue{ID: 3}, msg.Value)
+		require.Equal(t, []*topicreader.Message{poison, otherVersion}, handled)
+		require.Equal(t, []topicreader.CommitRangeGetter{poison, otherVersion}, source.committed)
+	})
+	t.Run("PoisonSkippedWithCommitModeNone", func(t *testing.T) {
+		source := &testTypedReaderSource{
+			messages: []*topicreader.Message{
+				newMessage(`bad`, ""),
+				newMessage(`{"ID":2}`, ""),
+			},
+			commitErr: topicreaderinternal.ErrCommitDisabled,
+		}
+		r := newTypedReader[testTypedValue](source, JSONCodec[testTypedValue]{},
+			WithReaderPoisonMessageHandler(func(ctx context.Context, msg *topicreader.Message, decodeErr error) error {
+				return nil
+			}),
+		)
+
+		msg, err := r.ReadMessage(ctx)
+		require.NoError(t, err)
+		require.Equal(t, testTypedValue{ID: 2}, msg.Value)
+		require.Empty(t, source.committed)
+	})
+	t.Run("PoisonSkippedWithWrongCommitOrder", func(t *testing.T) {
+		// sync commit mode: previous message is not committed before read of poisoned message
+		source := &testTypedReaderSource{
+			messages: []*topicreader.Message{
+				newMessage(`bad`, ""),
+			},
+			commitErr: topicreaderinternal.ErrWrongCommitOrderInSyncMode,
+		}
+		r := newTypedReader[testTypedValue](source, JSONCodec[testTypedValue]{},
+			WithReaderPoisonMessageHandler(func(ctx context.Context, msg *topicreader.Message, decodeErr error) error {
+				return nil
+			}),
+		)
+
+		_, err := r.ReadMessage(ctx)
+		require.ErrorIs(t, err, topicreaderinternal.ErrWrongCommitOrderInSyncMode)
+	})
+	t.Run("PoisonHandlerError", func(t *testing.T) {
+		testErr := errors.New("test")
+		source := &testTypedReaderSource{messages: []*topicreader.Message{
+			newMessage(`bad`, ""),
+		}}
+		r := newTypedReader[testTypedValue](source, JSONCodec[testTypedValue]{},
+			WithReaderPoisonMessageHandler(func(ctx context.Context, msg *topicreader.Message, decodeErr error) error {
+				return testErr
+			}),
+		)
+
+		_, err := r.ReadMessage(ctx)
+		require.ErrorIs(t, err, testErr)
+		require.Empty(t, source.committed)
+	})
+}
+
+type testTypedReaderSource struct {
+	messages  []*topicreader.Message
+	committed []topicreader.CommitRangeGetter
+	commitErr error
+}
+
+func (s *testTypedReaderSource) ReadMessage(ctx context.Context) (*topicreader.Message, error) {
+	if len(s.messages) == 0 {
+		return nil, io.EOF
+	}
+	msg := s.messages[0]
+	s.messages = s.messages[1:]
+
+	return msg, nil
+}
+
+func (s *testTypedReaderSource) ReadMessagesBatch(
+	ctx context.Context,
+	opts ...topicreader.ReadBatchOption,
+) (*topicreader.Batch, error) {
+	return nil, io.EOF
+}
+
+func (s *testTypedReaderSource) Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error {
+	if s.commitErr != nil {
+		return s.commitErr
+	}
+	s.committed = append(s.committed, obj)
+
+	return nil
+}
+
+func (s *testTypedReaderSource) Close(ctx context.Context) error {
+	return nil
+}
@@ -8,6 +8,23 @@ import (
 
 type (
 	Message = topicwriterinternal.PublicMessage
+
+	// WriteAck is server acknowledgement for written message: partition, offset and write status
+	WriteAck = topicwriterinternal.PublicWriteAck
+
+	// WriteAckStatus is status of written message
+	WriteAckStatus = topicwriterinternal.PublicWriteAckStatus
+
+	// WriteAckFuture completes after server acknowledged all messages of one WriteWithAck call
+	WriteAckFuture = topicwriterinternal.PublicWriteAckFuture
+)
+
+const (
+	// WriteAckStatusWritten - message written to the topic, WriteAck.Offset is valid
+	WriteAckStatusWritten = topicwriterinternal.PublicWriteAckStatusWritten
+
+	// WriteAckStatusSkipped - message skipped by server, for example deduplicated by producer id and seqno
+	WriteAckStatusSkipped = topicwriterinternal.PublicWriteAckStatusSkipped
 )
 
 var ErrQueueLimitExceed = topicwriterinternal.PublicErrQueueIsFull
@@ -43,6 +60,24 @@ func (w *Writer) Write(ctx context.Context, messages ...Message) error {
 	return w.inner.Write(ctx, messages...)
 }
 
+// WriteWithAck send messages to topic and return future for receive acks
+// acks contains partition, offset and written/skipped status for every message in same order as messages.
+//
+// The method return after put messages into buffer, even in sync mode (topicoptions.WithSyncWrite).
+// Use WriteAckFuture.Wait for wait acks from server.
+//
+// It returns ErrQueueLimitExceed (must be checked by errors.Is)
+// if ctx cancelled before messages put to internal buffer or try to add more messages, that can be put to queue.
+func (w *Writer) WriteWithAck(ctx context.Context, messages ...Message) (*WriteAckFuture, error) {
+	return w.inner.WriteWithAck(ctx, messages...)
+}
+
+// Flush waits until all messages, written before call, will be acknowledged by server
+// it returns error if writer closed or ctx cancelled before all acks received.
+func (w *Writer) Flush(ctx context.Context) error {
+	return w.inner.Flush(ctx)
+}
+
 // WaitInit waits until the reader is initialized
 // or an error occurs, return PublicInitialInfo and err.
 func (w *Writer) WaitInit(ctx context.Context) (err error) {
@@ -34,3 +34,55 @@ func ExampleWriter_Write() {
 		log.Fatalf("failed write to stream")
 	}
 }
+
+func ExampleWriter_WriteWithAck() {
+	ctx := context.Background()
+	db, err := ydb.Open(ctx, os.Getenv("YDB_CONNECTION_STRING"))
+	if err != nil {
+		log.Fatalf("failed ydb connection: %v", err)
+	}
+
+	writer, err := db.Topic().StartWriter("topicName")
+	if err != nil {
+		log.Fatalf("failed to create topic writer: %v", err)
+	}
+
+	future, err := writer.WriteWithAck(ctx,
+		topicwriter.Message{Data: strings.NewReader("1")},
+		topicwriter.Message{Data: strings.NewReader("2")},
+	)
+	if err != nil {
+		log.Fatalf("failed write to stream: %v", err)
+	}
+
+	acks, err := future.Wait(ctx)
+	if err != nil {
+		log.Fatalf("failed to wait acks: %v", err)
+	}
+	for _, ack := range acks {
+		fmt.Println(ack.PartitionID, ack.Offset, ack.Status)
+	}
+}
+
+func ExampleWriter_Flush() {
+	ctx := context.Background()
+	db, err := ydb.Open(ctx, os.Getenv("YDB_CONNECTION_STRING"))
+	if err != nil {
+		log.Fatalf("failed ydb connection: %v", err)
+	}
+
+	writer, err := db.Topic().StartWriter("topicName")
+	if err != nil {
+		log.Fatalf("failed to create topic writer: %v", err)
+	}
+
+	err = writer.Write(ctx, topicwriter.Message{Data: strings.NewReader("1")})
+	if err != nil {
+		log.Fatalf("failed write to stream: %v", err)
+	}
+
+	// wait until server acknowledge all written messages
+	if err = writer.Flush(ctx); err != nil {
+		log.Fatalf("failed to flush writer: %v", err)
+	}
+}
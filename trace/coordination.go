@@ -1,5 +1,10 @@
 package trace
 
+import (
+	"context"
+	"time"
+)
+
 // tool gtrace used from ./internal/cmd/gtrace
 
 //go:generate gtrace
@@ -7,5 +12,80 @@ package trace
 type (
 	// Coordination specified trace of coordination client activity.
 	// gtrace:gen
-	Coordination struct{}
+	Coordination struct {
+		OnSession      func(CoordinationSessionStartInfo) func(CoordinationSessionDoneInfo)
+		OnSessionStart func(CoordinationSessionStartStartInfo) func(CoordinationSessionStartDoneInfo)
+		OnSessionClose func(CoordinationSessionCloseStartInfo) func(CoordinationSessionCloseDoneInfo)
+
+		OnSessionKeepAliveTimeout func(CoordinationSessionKeepAliveTimeoutInfo)
+		OnSessionServerError      func(CoordinationSessionServerErrorInfo)
+		OnSessionLost             func(CoordinationSessionLostInfo)
+
+		OnSessionRequest func(CoordinationSessionRequestStartInfo) func(CoordinationSessionRequestDoneInfo)
+	}
+
+	CoordinationSessionStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+		Path    string
+	}
+	CoordinationSessionDoneInfo struct {
+		SessionID uint64
+		Error     error
+	}
+	// CoordinationSessionStartStartInfo is info about start or reattach of session on new stream
+	CoordinationSessionStartStartInfo struct {
+		Path string
+		// SessionID is ID of reattached session, zero for new session
+		SessionID uint64
+	}
+	CoordinationSessionStartDoneInfo struct {
+		SessionID uint64
+		Error     error
+	}
+	CoordinationSessionCloseStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context   *context.Context
+		Call      call
+		SessionID uint64
+	}
+	CoordinationSessionCloseDoneInfo struct {
+		Error error
+	}
+	CoordinationSessionKeepAliveTimeoutInfo struct {
+		SessionID        uint64
+		LastResponseTime time.Time
+		Timeout          time.Duration
+	}
+	// CoordinationSessionServerErrorInfo is info about failure message from server
+	CoordinationSessionServerErrorInfo struct {
+		SessionID uint64
+		Error     error
+	}
+	CoordinationSessionLostInfo struct {
+		SessionID uint64
+		Error     error
+	}
+	CoordinationSessionRequestStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context   *context.Context
+		Call      call
+		SessionID uint64
+		// Method is name of session request, for example "AcquireSemaphore"
+		Method    string
+		Semaphore string
+	}
+	CoordinationSessionRequestDoneInfo struct {
+		Error error
+	}
 )
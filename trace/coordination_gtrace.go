@@ -2,6 +2,11 @@
 
 package trace
 
+import (
+	"context"
+	"time"
+)
+
 // coordinationComposeOptions is a holder of options.
 type coordinationComposeOptions struct {
 	panicCallback func(e interface{})
@@ -20,5 +25,359 @@ func WithCoordinationPanicCallback(cb func(e interface{})) CoordinationComposeOp
 // Compose returns a new Coordination which has functional fields composed both from t and x.
 func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOption) *Coordination {
 	var ret Coordination
+	options := coordinationComposeOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+	{
+		h1 := t.OnSession
+		h2 := x.OnSession
+		ret.OnSession = func(c CoordinationSessionStartInfo) func(CoordinationSessionDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(CoordinationSessionDoneInfo)
+			if h1 != nil {
+				r = h1(c)
+			}
+			if h2 != nil {
+				r1 = h2(c)
+			}
+			return func(c CoordinationSessionDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(c)
+				}
+				if r1 != nil {
+					r1(c)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionStart
+		h2 := x.OnSessionStart
+		ret.OnSessionStart = func(c CoordinationSessionStartStartInfo) func(CoordinationSessionStartDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(CoordinationSessionStartDoneInfo)
+			if h1 != nil {
+				r = h1(c)
+			}
+			if h2 != nil {
+				r1 = h2(c)
+			}
+			return func(c CoordinationSessionStartDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(c)
+				}
+				if r1 != nil {
+					r1(c)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionClose
+		h2 := x.OnSessionClose
+		ret.OnSessionClose = func(c CoordinationSessionCloseStartInfo) func(CoordinationSessionCloseDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(CoordinationSessionCloseDoneInfo)
+			if h1 != nil {
+				r = h1(c)
+			}
+			if h2 != nil {
+				r1 = h2(c)
+			}
+			return func(c CoordinationSessionCloseDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(c)
+				}
+				if r1 != nil {
+					r1(c)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionKeepAliveTimeout
+		h2 := x.OnSessionKeepAliveTimeout
+		ret.OnSessionKeepAliveTimeout = func(c CoordinationSessionKeepAliveTimeoutInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionServerError
+		h2 := x.OnSessionServerError
+		ret.OnSessionServerError = func(c CoordinationSessionServerErrorInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionLost
+		h2 := x.OnSessionLost
+		ret.OnSessionLost = func(c CoordinationSessionLostInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionRequest
+		h2 := x.OnSessionRequest
+		ret.OnSessionRequest = func(c CoordinationSessionRequestStartInfo) func(CoordinationSessionRequestDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(CoordinationSessionRequestDoneInfo)
+			if h1 != nil {
+				r = h1(c)
+			}
+			if h2 != nil {
+				r1 = h2(c)
+			}
+			return func(c CoordinationSessionRequestDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(c)
+				}
+				if r1 != nil {
+					r1(c)
+				}
+			}
+		}
+	}
 	return &ret
 }
+func (t *Coordination) onSession(c CoordinationSessionStartInfo) func(CoordinationSessionDoneInfo) {
+	fn := t.OnSession
+	if fn == nil {
+		return func(CoordinationSessionDoneInfo) {
+			return
+		}
+	}
+	res := fn(c)
+	if res == nil {
+		return func(CoordinationSessionDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Coordination) onSessionStart(c CoordinationSessionStartStartInfo) func(CoordinationSessionStartDoneInfo) {
+	fn := t.OnSessionStart
+	if fn == nil {
+		return func(CoordinationSessionStartDoneInfo) {
+			return
+		}
+	}
+	res := fn(c)
+	if res == nil {
+		return func(CoordinationSessionStartDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Coordination) onSessionClose(c CoordinationSessionCloseStartInfo) func(CoordinationSessionCloseDoneInfo) {
+	fn := t.OnSessionClose
+	if fn == nil {
+		return func(CoordinationSessionCloseDoneInfo) {
+			return
+		}
+	}
+	res := fn(c)
+	if res == nil {
+		return func(CoordinationSessionCloseDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Coordination) onSessionKeepAliveTimeout(c CoordinationSessionKeepAliveTimeoutInfo) {
+	fn := t.OnSessionKeepAliveTimeout
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
+func (t *Coordination) onSessionServerError(c CoordinationSessionServerErrorInfo) {
+	fn := t.OnSessionServerError
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
+func (t *Coordination) onSessionLost(c CoordinationSessionLostInfo) {
+	fn := t.OnSessionLost
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
+func (t *Coordination) onSessionRequest(c CoordinationSessionRequestStartInfo) func(CoordinationSessionRequestDoneInfo) {
+	fn := t.OnSessionRequest
+	if fn == nil {
+		return func(CoordinationSessionRequestDoneInfo) {
+			return
+		}
+	}
+	res := fn(c)
+	if res == nil {
+		return func(CoordinationSessionRequestDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func CoordinationOnSession(t *Coordination, c *context.Context, call call, path string) func(sessionID uint64, _ error) {
+	var p CoordinationSessionStartInfo
+	p.Context = c
+	p.Call = call
+	p.Path = path
+	res := t.onSession(p)
+	return func(sessionID uint64, e error) {
+		var p CoordinationSessionDoneInfo
+		p.SessionID = sessionID
+		p.Error = e
+		res(p)
+	}
+}
+func CoordinationOnSessionStart(t *Coordination, path string, sessionID uint64) func(sessionID uint64, _ error) {
+	var p CoordinationSessionStartStartInfo
+	p.Path = path
+	p.SessionID = sessionID
+	res := t.onSessionStart(p)
+	return func(sessionID uint64, e error) {
+		var p CoordinationSessionStartDoneInfo
+		p.SessionID = sessionID
+		p.Error = e
+		res(p)
+	}
+}
+func CoordinationOnSessionClose(t *Coordination, c *context.Context, call call, sessionID uint64) func(error) {
+	var p CoordinationSessionCloseStartInfo
+	p.Context = c
+	p.Call = call
+	p.SessionID = sessionID
+	res := t.onSessionClose(p)
+	return func(e error) {
+		var p CoordinationSessionCloseDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+func CoordinationOnSessionKeepAliveTimeout(t *Coordination, sessionID uint64, lastResponseTime time.Time, timeout time.Duration) {
+	var p CoordinationSessionKeepAliveTimeoutInfo
+	p.SessionID = sessionID
+	p.LastResponseTime = lastResponseTime
+	p.Timeout = timeout
+	t.onSessionKeepAliveTimeout(p)
+}
+func CoordinationOnSessionServerError(t *Coordination, sessionID uint64, e error) {
+	var p CoordinationSessionServerErrorInfo
+	p.SessionID = sessionID
+	p.Error = e
+	t.onSessionServerError(p)
+}
+func CoordinationOnSessionLost(t *Coordination, sessionID uint64, e error) {
+	var p CoordinationSessionLostInfo
+	p.SessionID = sessionID
+	p.Error = e
+	t.onSessionLost(p)
+}
+func CoordinationOnSessionRequest(t *Coordination, c *context.Context, call call, sessionID uint64, method string, semaphore string) func(error) {
+	var p CoordinationSessionRequestStartInfo
+	p.Context = c
+	p.Call = call
+	p.SessionID = sessionID
+	p.Method = method
+	p.Semaphore = semaphore
+	res := t.onSessionRequest(p)
+	return func(e error) {
+		var p CoordinationSessionRequestDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
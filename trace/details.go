@@ -81,6 +81,8 @@ const (
 	// Deprecated: has no effect now.
 	DriverClusterEvents
 
+	MultiClusterEvents
+
 	DriverEvents = DriverConnEvents |
 		DriverBalancerEvents |
 		DriverResolverEvents |
@@ -145,6 +147,8 @@ var (
 
 		RatelimiterEvents: "ydb.ratelimiter",
 
+		MultiClusterEvents: "ydb.multicluster",
+
 		TableEvents:                     "ydb.table",
 		TableSessionLifeCycleEvents:     "ydb.table.session",
 		TableSessionQueryInvokeEvents:   "ydb.table.session.query.invoke",
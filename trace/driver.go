@@ -20,6 +20,10 @@ type (
 		OnWith  func(DriverWithStartInfo) func(DriverWithDoneInfo)
 		OnClose func(DriverCloseStartInfo) func(DriverCloseDoneInfo)
 
+		// Drain phase of close: new calls are rejected and in-flight calls are finished
+		OnDrain       func(DriverDrainStartInfo) func(DriverDrainDoneInfo)
+		OnDrainClient func(DriverDrainClientStartInfo) func(DriverDrainClientDoneInfo)
+
 		// Pool of connections
 		OnPoolNew     func(DriverConnPoolNewStartInfo) func(DriverConnPoolNewDoneInfo)
 		OnPoolRelease func(DriverConnPoolReleaseStartInfo) func(DriverConnPoolReleaseDoneInfo)
@@ -79,6 +83,12 @@ type (
 		)
 		OnBalancerUpdate func(DriverBalancerUpdateStartInfo) func(DriverBalancerUpdateDoneInfo)
 
+		// OnBalancerEndpointScore called by latency-aware balancer after each call with new score of endpoint
+		OnBalancerEndpointScore func(DriverBalancerEndpointScoreInfo)
+
+		// OnBalancerOutlierStateChange called by outlier detection on change of state of endpoint
+		OnBalancerOutlierStateChange func(DriverBalancerOutlierStateChangeInfo)
+
 		// Credentials events
 		OnGetCredentials func(DriverGetCredentialsStartInfo) func(DriverGetCredentialsDoneInfo)
 	}
@@ -175,6 +185,25 @@ type (
 		// Deprecated: this field always nil
 		Error error
 	}
+	DriverBalancerEndpointScoreInfo struct {
+		Endpoint EndpointInfo
+		// Latency is exponentially weighted moving average of round trip time of calls to endpoint
+		Latency time.Duration
+		// Inflight is count of calls to endpoint in progress
+		Inflight int64
+		// Score is used for choice from two random endpoints, endpoint with lower score is chosen
+		Score float64
+	}
+	DriverBalancerOutlierStateChangeInfo struct {
+		Endpoint EndpointInfo
+		// PreviousState and State are states of endpoint: "healthy", "ejected" or "half-open"
+		PreviousState string
+		State         string
+		// Reason is reason of change of state
+		Reason string
+		// EjectionTime is duration of ejection for "ejected" state
+		EjectionTime time.Duration
+	}
 	DriverBalancerClusterDiscoveryAttemptStartInfo struct {
 		// Context make available context in trace callback function.
 		// Pointer to context provide replacement of context in trace callback function.
@@ -465,4 +494,34 @@ type (
 	DriverCloseDoneInfo struct {
 		Error error
 	}
+	DriverDrainStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+		Timeout time.Duration
+		// Inflight is a count of in-flight calls of clients, started readers and writers
+		Inflight int
+	}
+	DriverDrainDoneInfo struct {
+		// Inflight is a count of not finished in-flight calls after drain
+		Inflight int
+		Error    error
+	}
+	DriverDrainClientStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context  *context.Context
+		Call     call
+		Client   string
+		Inflight int
+	}
+	DriverDrainClientDoneInfo struct {
+		Inflight int
+		Error    error
+	}
 )
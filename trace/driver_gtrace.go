@@ -4,6 +4,7 @@ package trace
 
 import (
 	"context"
+	"time"
 )
 
 // driverComposeOptions is a holder of options.
@@ -135,6 +136,76 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 			}
 		}
 	}
+	{
+		h1 := t.OnDrain
+		h2 := x.OnDrain
+		ret.OnDrain = func(d DriverDrainStartInfo) func(DriverDrainDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(DriverDrainDoneInfo)
+			if h1 != nil {
+				r = h1(d)
+			}
+			if h2 != nil {
+				r1 = h2(d)
+			}
+			return func(d DriverDrainDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(d)
+				}
+				if r1 != nil {
+					r1(d)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnDrainClient
+		h2 := x.OnDrainClient
+		ret.OnDrainClient = func(d DriverDrainClientStartInfo) func(DriverDrainClientDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(DriverDrainClientDoneInfo)
+			if h1 != nil {
+				r = h1(d)
+			}
+			if h2 != nil {
+				r1 = h2(d)
+			}
+			return func(d DriverDrainClientDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(d)
+				}
+				if r1 != nil {
+					r1(d)
+				}
+			}
+		}
+	}
 	{
 		h1 := t.OnPoolNew
 		h2 := x.OnPoolNew
@@ -956,6 +1027,44 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 			}
 		}
 	}
+	{
+		h1 := t.OnBalancerEndpointScore
+		h2 := x.OnBalancerEndpointScore
+		ret.OnBalancerEndpointScore = func(d DriverBalancerEndpointScoreInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(d)
+			}
+			if h2 != nil {
+				h2(d)
+			}
+		}
+	}
+	{
+		h1 := t.OnBalancerOutlierStateChange
+		h2 := x.OnBalancerOutlierStateChange
+		ret.OnBalancerOutlierStateChange = func(d DriverBalancerOutlierStateChangeInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(d)
+			}
+			if h2 != nil {
+				h2(d)
+			}
+		}
+	}
 	{
 		h1 := t.OnGetCredentials
 		h2 := x.OnGetCredentials
@@ -1038,6 +1147,36 @@ func (t *Driver) onClose(d DriverCloseStartInfo) func(DriverCloseDoneInfo) {
 	}
 	return res
 }
+func (t *Driver) onDrain(d DriverDrainStartInfo) func(DriverDrainDoneInfo) {
+	fn := t.OnDrain
+	if fn == nil {
+		return func(DriverDrainDoneInfo) {
+			return
+		}
+	}
+	res := fn(d)
+	if res == nil {
+		return func(DriverDrainDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Driver) onDrainClient(d DriverDrainClientStartInfo) func(DriverDrainClientDoneInfo) {
+	fn := t.OnDrainClient
+	if fn == nil {
+		return func(DriverDrainClientDoneInfo) {
+			return
+		}
+	}
+	res := fn(d)
+	if res == nil {
+		return func(DriverDrainClientDoneInfo) {
+			return
+		}
+	}
+	return res
+}
 func (t *Driver) onPoolNew(d DriverConnPoolNewStartInfo) func(DriverConnPoolNewDoneInfo) {
 	fn := t.OnPoolNew
 	if fn == nil {
@@ -1395,6 +1534,20 @@ func (t *Driver) onBalancerUpdate(d DriverBalancerUpdateStartInfo) func(DriverBa
 	}
 	return res
 }
+func (t *Driver) onBalancerEndpointScore(d DriverBalancerEndpointScoreInfo) {
+	fn := t.OnBalancerEndpointScore
+	if fn == nil {
+		return
+	}
+	fn(d)
+}
+func (t *Driver) onBalancerOutlierStateChange(d DriverBalancerOutlierStateChangeInfo) {
+	fn := t.OnBalancerOutlierStateChange
+	if fn == nil {
+		return
+	}
+	fn(d)
+}
 func (t *Driver) onGetCredentials(d DriverGetCredentialsStartInfo) func(DriverGetCredentialsDoneInfo) {
 	fn := t.OnGetCredentials
 	if fn == nil {
@@ -1449,6 +1602,34 @@ func DriverOnClose(t *Driver, c *context.Context, call call) func(error) {
 		res(p)
 	}
 }
+func DriverOnDrain(t *Driver, c *context.Context, call call, timeout time.Duration, inflight int) func(inflight int, _ error) {
+	var p DriverDrainStartInfo
+	p.Context = c
+	p.Call = call
+	p.Timeout = timeout
+	p.Inflight = inflight
+	res := t.onDrain(p)
+	return func(inflight int, e error) {
+		var p DriverDrainDoneInfo
+		p.Inflight = inflight
+		p.Error = e
+		res(p)
+	}
+}
+func DriverOnDrainClient(t *Driver, c *context.Context, call call, client string, inflight int) func(inflight int, _ error) {
+	var p DriverDrainClientStartInfo
+	p.Context = c
+	p.Call = call
+	p.Client = client
+	p.Inflight = inflight
+	res := t.onDrainClient(p)
+	return func(inflight int, e error) {
+		var p DriverDrainClientDoneInfo
+		p.Inflight = inflight
+		p.Error = e
+		res(p)
+	}
+}
 func DriverOnPoolNew(t *Driver, c *context.Context, call call) func() {
 	var p DriverConnPoolNewStartInfo
 	p.Context = c
@@ -1744,6 +1925,23 @@ func DriverOnBalancerUpdate(t *Driver, c *context.Context, call call, needLocalD
 		res(p)
 	}
 }
+func DriverOnBalancerEndpointScore(t *Driver, endpoint EndpointInfo, latency time.Duration, inflight int64, score float64) {
+	var p DriverBalancerEndpointScoreInfo
+	p.Endpoint = endpoint
+	p.Latency = latency
+	p.Inflight = inflight
+	p.Score = score
+	t.onBalancerEndpointScore(p)
+}
+func DriverOnBalancerOutlierStateChange(t *Driver, endpoint EndpointInfo, previousState string, state string, reason string, ejectionTime time.Duration) {
+	var p DriverBalancerOutlierStateChangeInfo
+	p.Endpoint = endpoint
+	p.PreviousState = previousState
+	p.State = state
+	p.Reason = reason
+	p.EjectionTime = ejectionTime
+	t.onBalancerOutlierStateChange(p)
+}
 func DriverOnGetCredentials(t *Driver, c *context.Context, call call) func(token string, _ error) {
 	var p DriverGetCredentialsStartInfo
 	p.Context = c
@@ -0,0 +1,44 @@
+package trace
+
+import (
+	"context"
+	"time"
+)
+
+// tool gtrace used from ./internal/cmd/gtrace
+
+//go:generate gtrace
+
+type (
+	// MultiCluster specified trace of multi-cluster driver activity.
+	// gtrace:gen
+	MultiCluster struct {
+		OnCall         func(MultiClusterCallStartInfo) func(MultiClusterCallDoneInfo)
+		OnHealthChange func(MultiClusterHealthChangeInfo)
+	}
+
+	MultiClusterCallStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+		// Method is name of called method, for example "table.DoTx" or "topic.StartReader"
+		Method string
+		// Cluster is name of cluster, which serves the call
+		Cluster  string
+		ReadOnly bool
+	}
+	MultiClusterCallDoneInfo struct {
+		Error error
+	}
+	// MultiClusterHealthChangeInfo is info about change of health of cluster by health probe
+	MultiClusterHealthChangeInfo struct {
+		Cluster string
+		Healthy bool
+		// Latency is round trip time of health probe
+		Latency time.Duration
+		Error   error
+	}
+)
@@ -12,6 +12,13 @@ type (
 	Query struct {
 		OnDo   func(QueryDoStartInfo) func(info QueryDoIntermediateInfo) func(QueryDoDoneInfo)
 		OnDoTx func(QueryDoTxStartInfo) func(info QueryDoTxIntermediateInfo) func(QueryDoTxDoneInfo)
+
+		OnSessionCreate func(QuerySessionCreateStartInfo) func(QuerySessionCreateDoneInfo)
+		OnSessionDelete func(QuerySessionDeleteStartInfo) func(QuerySessionDeleteDoneInfo)
+		OnSessionClose  func(QuerySessionCloseInfo)
+
+		OnSessionExecute func(QuerySessionExecuteStartInfo) func(QuerySessionExecuteDoneInfo)
+		OnResultNextPart func(QueryResultNextPartInfo)
 	}
 
 	QueryDoStartInfo struct {
@@ -21,6 +28,7 @@ type (
 		// Safe replacement of context are provided only inside callback function
 		Context *context.Context
 		Call    call
+		Label   string
 	}
 	QueryDoIntermediateInfo struct {
 		Error error
@@ -36,6 +44,7 @@ type (
 		// Safe replacement of context are provided only inside callback function
 		Context *context.Context
 		Call    call
+		Label   string
 	}
 	QueryDoTxIntermediateInfo struct {
 		Error error
@@ -44,4 +53,57 @@ type (
 		Attempts int
 		Error    error
 	}
+	QuerySessionCreateStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+	}
+	QuerySessionCreateDoneInfo struct {
+		SessionID string
+		NodeID    int64
+		Error     error
+	}
+	QuerySessionDeleteStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context   *context.Context
+		Call      call
+		SessionID string
+		NodeID    int64
+	}
+	QuerySessionDeleteDoneInfo struct {
+		Error error
+	}
+	// QuerySessionCloseInfo is info about closing of session attach stream.
+	// Closed session is removed from pool.
+	QuerySessionCloseInfo struct {
+		SessionID string
+		NodeID    int64
+	}
+	QuerySessionExecuteStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context   *context.Context
+		Call      call
+		SessionID string
+		Query     string
+		// Label of Do or DoTx call (query.WithLabel), empty if execute called outside of Do and DoTx
+		Label string
+	}
+	QuerySessionExecuteDoneInfo struct {
+		Error error
+	}
+	// QueryResultNextPartInfo is info about received part of query result stream
+	QueryResultNextPartInfo struct {
+		SessionID  string
+		Label      string
+		BytesCount int
+	}
 )
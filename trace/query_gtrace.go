@@ -132,6 +132,149 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 			}
 		}
 	}
+	{
+		h1 := t.OnSessionCreate
+		h2 := x.OnSessionCreate
+		ret.OnSessionCreate = func(q QuerySessionCreateStartInfo) func(QuerySessionCreateDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(QuerySessionCreateDoneInfo)
+			if h1 != nil {
+				r = h1(q)
+			}
+			if h2 != nil {
+				r1 = h2(q)
+			}
+			return func(q QuerySessionCreateDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(q)
+				}
+				if r1 != nil {
+					r1(q)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionDelete
+		h2 := x.OnSessionDelete
+		ret.OnSessionDelete = func(q QuerySessionDeleteStartInfo) func(QuerySessionDeleteDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(QuerySessionDeleteDoneInfo)
+			if h1 != nil {
+				r = h1(q)
+			}
+			if h2 != nil {
+				r1 = h2(q)
+			}
+			return func(q QuerySessionDeleteDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(q)
+				}
+				if r1 != nil {
+					r1(q)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionClose
+		h2 := x.OnSessionClose
+		ret.OnSessionClose = func(q QuerySessionCloseInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(q)
+			}
+			if h2 != nil {
+				h2(q)
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionExecute
+		h2 := x.OnSessionExecute
+		ret.OnSessionExecute = func(q QuerySessionExecuteStartInfo) func(QuerySessionExecuteDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(QuerySessionExecuteDoneInfo)
+			if h1 != nil {
+				r = h1(q)
+			}
+			if h2 != nil {
+				r1 = h2(q)
+			}
+			return func(q QuerySessionExecuteDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(q)
+				}
+				if r1 != nil {
+					r1(q)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnResultNextPart
+		h2 := x.OnResultNextPart
+		ret.OnResultNextPart = func(q QueryResultNextPartInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(q)
+			}
+			if h2 != nil {
+				h2(q)
+			}
+		}
+	}
 	return &ret
 }
 func (t *Query) onDo(q QueryDoStartInfo) func(info QueryDoIntermediateInfo) func(QueryDoDoneInfo) {
@@ -188,10 +331,70 @@ func (t *Query) onDoTx(q QueryDoTxStartInfo) func(info QueryDoTxIntermediateInfo
 		return res
 	}
 }
-func QueryOnDo(t *Query, c *context.Context, call call) func(error) func(attempts int, _ error) {
+func (t *Query) onSessionCreate(q QuerySessionCreateStartInfo) func(QuerySessionCreateDoneInfo) {
+	fn := t.OnSessionCreate
+	if fn == nil {
+		return func(QuerySessionCreateDoneInfo) {
+			return
+		}
+	}
+	res := fn(q)
+	if res == nil {
+		return func(QuerySessionCreateDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Query) onSessionDelete(q QuerySessionDeleteStartInfo) func(QuerySessionDeleteDoneInfo) {
+	fn := t.OnSessionDelete
+	if fn == nil {
+		return func(QuerySessionDeleteDoneInfo) {
+			return
+		}
+	}
+	res := fn(q)
+	if res == nil {
+		return func(QuerySessionDeleteDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Query) onSessionClose(q QuerySessionCloseInfo) {
+	fn := t.OnSessionClose
+	if fn == nil {
+		return
+	}
+	fn(q)
+}
+func (t *Query) onSessionExecute(q QuerySessionExecuteStartInfo) func(QuerySessionExecuteDoneInfo) {
+	fn := t.OnSessionExecute
+	if fn == nil {
+		return func(QuerySessionExecuteDoneInfo) {
+			return
+		}
+	}
+	res := fn(q)
+	if res == nil {
+		return func(QuerySessionExecuteDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Query) onResultNextPart(q QueryResultNextPartInfo) {
+	fn := t.OnResultNextPart
+	if fn == nil {
+		return
+	}
+	fn(q)
+}
+func QueryOnDo(t *Query, c *context.Context, call call, label string) func(error) func(attempts int, _ error) {
 	var p QueryDoStartInfo
 	p.Context = c
 	p.Call = call
+	p.Label = label
 	res := t.onDo(p)
 	return func(e error) func(int, error) {
 		var p QueryDoIntermediateInfo
@@ -205,10 +408,11 @@ func QueryOnDo(t *Query, c *context.Context, call call) func(error) func(attempt
 		}
 	}
 }
-func QueryOnDoTx(t *Query, c *context.Context, call call) func(error) func(attempts int, _ error) {
+func QueryOnDoTx(t *Query, c *context.Context, call call, label string) func(error) func(attempts int, _ error) {
 	var p QueryDoTxStartInfo
 	p.Context = c
 	p.Call = call
+	p.Label = label
 	res := t.onDoTx(p)
 	return func(e error) func(int, error) {
 		var p QueryDoTxIntermediateInfo
@@ -222,3 +426,56 @@ func QueryOnDoTx(t *Query, c *context.Context, call call) func(error) func(attem
 		}
 	}
 }
+func QueryOnSessionCreate(t *Query, c *context.Context, call call) func(sessionID string, nodeID int64, _ error) {
+	var p QuerySessionCreateStartInfo
+	p.Context = c
+	p.Call = call
+	res := t.onSessionCreate(p)
+	return func(sessionID string, nodeID int64, e error) {
+		var p QuerySessionCreateDoneInfo
+		p.SessionID = sessionID
+		p.NodeID = nodeID
+		p.Error = e
+		res(p)
+	}
+}
+func QueryOnSessionDelete(t *Query, c *context.Context, call call, sessionID string, nodeID int64) func(error) {
+	var p QuerySessionDeleteStartInfo
+	p.Context = c
+	p.Call = call
+	p.SessionID = sessionID
+	p.NodeID = nodeID
+	res := t.onSessionDelete(p)
+	return func(e error) {
+		var p QuerySessionDeleteDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+func QueryOnSessionClose(t *Query, sessionID string, nodeID int64) {
+	var p QuerySessionCloseInfo
+	p.SessionID = sessionID
+	p.NodeID = nodeID
+	t.onSessionClose(p)
+}
+func QueryOnSessionExecute(t *Query, c *context.Context, call call, sessionID string, query string, label string) func(error) {
+	var p QuerySessionExecuteStartInfo
+	p.Context = c
+	p.Call = call
+	p.SessionID = sessionID
+	p.Query = query
+	p.Label = label
+	res := t.onSessionExecute(p)
+	return func(e error) {
+		var p QuerySessionExecuteDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+func QueryOnResultNextPart(t *Query, sessionID string, label string, bytesCount int) {
+	var p QueryResultNextPartInfo
+	p.SessionID = sessionID
+	p.Label = label
+	p.BytesCount = bytesCount
+	t.onResultNextPart(p)
+}
@@ -1,5 +1,9 @@
 package trace
 
+import (
+	"context"
+)
+
 // tool gtrace used from ./internal/cmd/gtrace
 
 //go:generate gtrace
@@ -7,5 +11,32 @@ package trace
 type (
 	// Ratelimiter specified trace of ratelimiter client activity.
 	// gtrace:gen
-	Ratelimiter struct{}
+	Ratelimiter struct {
+		OnAcquireResource func(RatelimiterAcquireResourceStartInfo) func(RatelimiterAcquireResourceDoneInfo)
+		OnLimiterSpend    func(RatelimiterLimiterSpendInfo)
+	}
+
+	RatelimiterAcquireResourceStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context              *context.Context
+		Call                 call
+		CoordinationNodePath string
+		ResourcePath         string
+		Amount               uint64
+		// Report is true for report of used units (see ratelimiter.WithReport)
+		Report bool
+	}
+	RatelimiterAcquireResourceDoneInfo struct {
+		Error error
+	}
+	// RatelimiterLimiterSpendInfo is info about units spent from local limiter
+	RatelimiterLimiterSpendInfo struct {
+		ResourcePath string
+		Amount       uint64
+		// Local is true if units spent from prefetched quota without waiting for remote acquire
+		Local bool
+	}
 )
@@ -2,6 +2,10 @@
 
 package trace
 
+import (
+	"context"
+)
+
 // ratelimiterComposeOptions is a holder of options.
 type ratelimiterComposeOptions struct {
 	panicCallback func(e interface{})
@@ -20,5 +24,109 @@ func WithRatelimiterPanicCallback(cb func(e interface{})) RatelimiterComposeOpti
 // Compose returns a new Ratelimiter which has functional fields composed both from t and x.
 func (t *Ratelimiter) Compose(x *Ratelimiter, opts ...RatelimiterComposeOption) *Ratelimiter {
 	var ret Ratelimiter
+	options := ratelimiterComposeOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+	{
+		h1 := t.OnAcquireResource
+		h2 := x.OnAcquireResource
+		ret.OnAcquireResource = func(r RatelimiterAcquireResourceStartInfo) func(RatelimiterAcquireResourceDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r1, r2 func(RatelimiterAcquireResourceDoneInfo)
+			if h1 != nil {
+				r1 = h1(r)
+			}
+			if h2 != nil {
+				r2 = h2(r)
+			}
+			return func(r RatelimiterAcquireResourceDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r1 != nil {
+					r1(r)
+				}
+				if r2 != nil {
+					r2(r)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnLimiterSpend
+		h2 := x.OnLimiterSpend
+		ret.OnLimiterSpend = func(r RatelimiterLimiterSpendInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(r)
+			}
+			if h2 != nil {
+				h2(r)
+			}
+		}
+	}
 	return &ret
 }
+func (t *Ratelimiter) onAcquireResource(r RatelimiterAcquireResourceStartInfo) func(RatelimiterAcquireResourceDoneInfo) {
+	fn := t.OnAcquireResource
+	if fn == nil {
+		return func(RatelimiterAcquireResourceDoneInfo) {
+			return
+		}
+	}
+	res := fn(r)
+	if res == nil {
+		return func(RatelimiterAcquireResourceDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Ratelimiter) onLimiterSpend(r RatelimiterLimiterSpendInfo) {
+	fn := t.OnLimiterSpend
+	if fn == nil {
+		return
+	}
+	fn(r)
+}
+func RatelimiterOnAcquireResource(t *Ratelimiter, c *context.Context, call call, coordinationNodePath string, resourcePath string, amount uint64, report bool) func(error) {
+	var p RatelimiterAcquireResourceStartInfo
+	p.Context = c
+	p.Call = call
+	p.CoordinationNodePath = coordinationNodePath
+	p.ResourcePath = resourcePath
+	p.Amount = amount
+	p.Report = report
+	res := t.onAcquireResource(p)
+	return func(e error) {
+		var p RatelimiterAcquireResourceDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+func RatelimiterOnLimiterSpend(t *Ratelimiter, resourcePath string, amount uint64, local bool) {
+	var p RatelimiterLimiterSpendInfo
+	p.ResourcePath = resourcePath
+	p.Amount = amount
+	p.Local = local
+	t.onLimiterSpend(p)
+}
@@ -6,6 +6,7 @@ package trace
 
 import (
 	"context"
+	"time"
 )
 
 type (
@@ -13,6 +14,20 @@ type (
 	// gtrace:gen
 	Retry struct {
 		OnRetry func(RetryLoopStartInfo) func(RetryLoopIntermediateInfo) func(RetryLoopDoneInfo)
+
+		// OnHedge is called when hedge delay of attempt is expired
+		//
+		// # Experimental
+		//
+		// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+		OnHedge func(RetryHedgeStartInfo) func(RetryHedgeDoneInfo)
+
+		// OnBudget is called when retry budget is consulted before retry
+		//
+		// # Experimental
+		//
+		// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+		OnBudget func(RetryBudgetInfo)
 	}
 	RetryLoopStartInfo struct {
 		// Context make available context in trace callback function.
@@ -37,4 +52,39 @@ type (
 		Attempts int
 		Error    error
 	}
+	RetryHedgeStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+		Label   string
+
+		// Delay is an adaptive delay of hedged request
+		Delay time.Duration
+		// Allowed is false if hedged request is not sent because hedging budget is exhausted
+		Allowed bool
+	}
+	RetryHedgeDoneInfo struct {
+		// HedgeWon is true if result of hedged request is taken
+		HedgeWon bool
+		Error    error
+	}
+	RetryBudgetInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+		Label   string
+
+		// Tokens is a count of tokens of retry budget after consult
+		Tokens float64
+		// Capacity is a current capacity of retry budget by rate of calls
+		Capacity float64
+		// Allowed is false if retry is rejected because retry budget is exhausted
+		Allowed bool
+	}
 )
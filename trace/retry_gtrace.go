@@ -4,6 +4,7 @@ package trace
 
 import (
 	"context"
+	"time"
 )
 
 // retryComposeOptions is a holder of options.
@@ -81,6 +82,60 @@ func (t *Retry) Compose(x *Retry, opts ...RetryComposeOption) *Retry {
 			}
 		}
 	}
+	{
+		h1 := t.OnHedge
+		h2 := x.OnHedge
+		ret.OnHedge = func(r RetryHedgeStartInfo) func(RetryHedgeDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r1, r2 func(RetryHedgeDoneInfo)
+			if h1 != nil {
+				r1 = h1(r)
+			}
+			if h2 != nil {
+				r2 = h2(r)
+			}
+			return func(r RetryHedgeDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r1 != nil {
+					r1(r)
+				}
+				if r2 != nil {
+					r2(r)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnBudget
+		h2 := x.OnBudget
+		ret.OnBudget = func(r RetryBudgetInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(r)
+			}
+			if h2 != nil {
+				h2(r)
+			}
+		}
+	}
 	return &ret
 }
 func (t *Retry) onRetry(r RetryLoopStartInfo) func(RetryLoopIntermediateInfo) func(RetryLoopDoneInfo) {
@@ -110,6 +165,28 @@ func (t *Retry) onRetry(r RetryLoopStartInfo) func(RetryLoopIntermediateInfo) fu
 		return res
 	}
 }
+func (t *Retry) onHedge(r RetryHedgeStartInfo) func(RetryHedgeDoneInfo) {
+	fn := t.OnHedge
+	if fn == nil {
+		return func(RetryHedgeDoneInfo) {
+			return
+		}
+	}
+	res := fn(r)
+	if res == nil {
+		return func(RetryHedgeDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Retry) onBudget(r RetryBudgetInfo) {
+	fn := t.OnBudget
+	if fn == nil {
+		return
+	}
+	fn(r)
+}
 func RetryOnRetry(t *Retry, c *context.Context, iD string, call call, label string, idempotent bool, nestedCall bool) func(error) func(attempts int, _ error) {
 	var p RetryLoopStartInfo
 	p.Context = c
@@ -131,3 +208,28 @@ func RetryOnRetry(t *Retry, c *context.Context, iD string, call call, label stri
 		}
 	}
 }
+func RetryOnHedge(t *Retry, c *context.Context, call call, label string, delay time.Duration, allowed bool) func(hedgeWon bool, _ error) {
+	var p RetryHedgeStartInfo
+	p.Context = c
+	p.Call = call
+	p.Label = label
+	p.Delay = delay
+	p.Allowed = allowed
+	res := t.onHedge(p)
+	return func(hedgeWon bool, e error) {
+		var p RetryHedgeDoneInfo
+		p.HedgeWon = hedgeWon
+		p.Error = e
+		res(p)
+	}
+}
+func RetryOnBudget(t *Retry, c *context.Context, call call, label string, tokens float64, capacity float64, allowed bool) {
+	var p RetryBudgetInfo
+	p.Context = c
+	p.Call = call
+	p.Label = label
+	p.Tokens = tokens
+	p.Capacity = capacity
+	p.Allowed = allowed
+	t.onBudget(p)
+}
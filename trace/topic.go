@@ -68,6 +68,7 @@ type (
 		OnWriterCompressMessages       func(TopicWriterCompressMessagesStartInfo) func(TopicWriterCompressMessagesDoneInfo)
 		OnWriterSendMessages           func(TopicWriterSendMessagesStartInfo) func(TopicWriterSendMessagesDoneInfo)
 		OnWriterReadUnknownGrpcMessage func(TopicOnWriterReadUnknownGrpcMessageInfo)
+		OnWriterQueueChanged           func(TopicWriterQueueChangedInfo)
 	}
 
 	TopicReaderPartitionReadStartResponseStartInfo struct {
@@ -76,6 +77,7 @@ type (
 		Topic              string
 		PartitionID        int64
 		PartitionSessionID int64
+		Consumer           string
 	}
 
 	TopicReaderStartInfo struct {
@@ -97,6 +99,7 @@ type (
 		PartitionSessionID int64
 		CommittedOffset    int64
 		Graceful           bool
+		Consumer           string
 	}
 
 	TopicReaderPartitionReadStopResponseDoneInfo struct {
@@ -172,6 +175,8 @@ type (
 		OffsetStart        int64
 		OffsetEnd          int64
 		FreeBufferCapacity int
+		Consumer           string
+		BytesCount         int
 		Error              error
 	}
 
@@ -181,7 +186,9 @@ type (
 	}
 
 	TopicReaderReconnectStartInfo struct {
-		Reason error
+		Reason   error
+		Consumer string
+		Topics   []string
 	}
 
 	TopicReaderReconnectDoneInfo struct {
@@ -200,6 +207,7 @@ type (
 		PartitionSessionID int64
 		StartOffset        int64
 		EndOffset          int64
+		Consumer           string
 	}
 
 	TopicReaderCommitDoneInfo struct {
@@ -283,6 +291,7 @@ type (
 		FirstSeqNo       int64
 		MessagesCount    int
 		Reason           TopicWriterCompressMessagesReason
+		Topic            string
 	}
 
 	TopicWriterCompressMessagesDoneInfo struct {
@@ -295,6 +304,8 @@ type (
 		Codec            int32
 		FirstSeqNo       int64
 		MessagesCount    int
+		Topic            string
+		BytesCount       int
 	}
 
 	TopicWriterSendMessagesDoneInfo struct {
@@ -306,6 +317,14 @@ type (
 		SessionID        string
 		Error            error
 	}
+
+	// TopicWriterQueueChangedInfo contains state of writer queue after add messages or receive acks
+	TopicWriterQueueChangedInfo struct {
+		WriterInstanceID string
+		Topic            string
+		MessagesCount    int
+		BytesCount       int
+	}
 )
 
 type TopicWriterCompressMessagesReason string
@@ -704,6 +704,25 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 			}
 		}
 	}
+	{
+		h1 := t.OnWriterQueueChanged
+		h2 := x.OnWriterQueueChanged
+		ret.OnWriterQueueChanged = func(t TopicWriterQueueChangedInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(t)
+			}
+			if h2 != nil {
+				h2(t)
+			}
+		}
+	}
 	return &ret
 }
 func (t *Topic) onReaderStart(info TopicReaderStartInfo) {
@@ -992,15 +1011,24 @@ func (t *Topic) onWriterReadUnknownGrpcMessage(t1 TopicOnWriterReadUnknownGrpcMe
 	}
 	fn(t1)
 }
+func (t *Topic) onWriterQueueChanged(t1 TopicWriterQueueChangedInfo) {
+	fn := t.OnWriterQueueChanged
+	if fn == nil {
+		return
+	}
+	fn(t1)
+}
 func TopicOnReaderStart(t *Topic, readerID int64, consumer string) {
 	var p TopicReaderStartInfo
 	p.ReaderID = readerID
 	p.Consumer = consumer
 	t.onReaderStart(p)
 }
-func TopicOnReaderReconnect(t *Topic, reason error) func(error) {
+func TopicOnReaderReconnect(t *Topic, reason error, consumer string, topics []string) func(error) {
 	var p TopicReaderReconnectStartInfo
 	p.Reason = reason
+	p.Consumer = consumer
+	p.Topics = topics
 	res := t.onReaderReconnect(p)
 	return func(e error) {
 		var p TopicReaderReconnectDoneInfo
@@ -1014,13 +1042,14 @@ func TopicOnReaderReconnectRequest(t *Topic, reason error, wasSent bool) {
 	p.WasSent = wasSent
 	t.onReaderReconnectRequest(p)
 }
-func TopicOnReaderPartitionReadStartResponse(t *Topic, readerConnectionID string, partitionContext context.Context, topic string, partitionID int64, partitionSessionID int64) func(readOffset *int64, commitOffset *int64, _ error) {
+func TopicOnReaderPartitionReadStartResponse(t *Topic, readerConnectionID string, partitionContext context.Context, topic string, partitionID int64, partitionSessionID int64, consumer string) func(readOffset *int64, commitOffset *int64, _ error) {
 	var p TopicReaderPartitionReadStartResponseStartInfo
 	p.ReaderConnectionID = readerConnectionID
 	p.PartitionContext = partitionContext
 	p.Topic = topic
 	p.PartitionID = partitionID
 	p.PartitionSessionID = partitionSessionID
+	p.Consumer = consumer
 	res := t.onReaderPartitionReadStartResponse(p)
 	return func(readOffset *int64, commitOffset *int64, e error) {
 		var p TopicReaderPartitionReadStartResponseDoneInfo
@@ -1030,7 +1059,7 @@ func TopicOnReaderPartitionReadStartResponse(t *Topic, readerConnectionID string
 		res(p)
 	}
 }
-func TopicOnReaderPartitionReadStopResponse(t *Topic, readerConnectionID string, partitionContext context.Context, topic string, partitionID int64, partitionSessionID int64, committedOffset int64, graceful bool) func(error) {
+func TopicOnReaderPartitionReadStopResponse(t *Topic, readerConnectionID string, partitionContext context.Context, topic string, partitionID int64, partitionSessionID int64, committedOffset int64, graceful bool, consumer string) func(error) {
 	var p TopicReaderPartitionReadStopResponseStartInfo
 	p.ReaderConnectionID = readerConnectionID
 	p.PartitionContext = partitionContext
@@ -1039,6 +1068,7 @@ func TopicOnReaderPartitionReadStopResponse(t *Topic, readerConnectionID string,
 	p.PartitionSessionID = partitionSessionID
 	p.CommittedOffset = committedOffset
 	p.Graceful = graceful
+	p.Consumer = consumer
 	res := t.onReaderPartitionReadStopResponse(p)
 	return func(e error) {
 		var p TopicReaderPartitionReadStopResponseDoneInfo
@@ -1046,7 +1076,7 @@ func TopicOnReaderPartitionReadStopResponse(t *Topic, readerConnectionID string,
 		res(p)
 	}
 }
-func TopicOnReaderCommit(t *Topic, requestContext context.Context, topic string, partitionID int64, partitionSessionID int64, startOffset int64, endOffset int64) func(error) {
+func TopicOnReaderCommit(t *Topic, requestContext context.Context, topic string, partitionID int64, partitionSessionID int64, startOffset int64, endOffset int64, consumer string) func(error) {
 	var p TopicReaderCommitStartInfo
 	p.RequestContext = requestContext
 	p.Topic = topic
@@ -1054,6 +1084,7 @@ func TopicOnReaderCommit(t *Topic, requestContext context.Context, topic string,
 	p.PartitionSessionID = partitionSessionID
 	p.StartOffset = startOffset
 	p.EndOffset = endOffset
+	p.Consumer = consumer
 	res := t.onReaderCommit(p)
 	return func(e error) {
 		var p TopicReaderCommitDoneInfo
@@ -1144,14 +1175,14 @@ func TopicOnReaderReceiveDataResponse(t *Topic, readerConnectionID string, local
 		res(p)
 	}
 }
-func TopicOnReaderReadMessages(t *Topic, requestContext context.Context, minCount int, maxCount int, freeBufferCapacity int) func(messagesCount int, topic string, partitionID int64, partitionSessionID int64, offsetStart int64, offsetEnd int64, freeBufferCapacity int, _ error) {
+func TopicOnReaderReadMessages(t *Topic, requestContext context.Context, minCount int, maxCount int, freeBufferCapacity int) func(messagesCount int, topic string, partitionID int64, partitionSessionID int64, offsetStart int64, offsetEnd int64, freeBufferCapacity int, consumer string, bytesCount int, _ error) {
 	var p TopicReaderReadMessagesStartInfo
 	p.RequestContext = requestContext
 	p.MinCount = minCount
 	p.MaxCount = maxCount
 	p.FreeBufferCapacity = freeBufferCapacity
 	res := t.onReaderReadMessages(p)
-	return func(messagesCount int, topic string, partitionID int64, partitionSessionID int64, offsetStart int64, offsetEnd int64, freeBufferCapacity int, e error) {
+	return func(messagesCount int, topic string, partitionID int64, partitionSessionID int64, offsetStart int64, offsetEnd int64, freeBufferCapacity int, consumer string, bytesCount int, e error) {
 		var p TopicReaderReadMessagesDoneInfo
 		p.MessagesCount = messagesCount
 		p.Topic = topic
@@ -1160,6 +1191,8 @@ func TopicOnReaderReadMessages(t *Topic, requestContext context.Context, minCoun
 		p.OffsetStart = offsetStart
 		p.OffsetEnd = offsetEnd
 		p.FreeBufferCapacity = freeBufferCapacity
+		p.Consumer = consumer
+		p.BytesCount = bytesCount
 		p.Error = e
 		res(p)
 	}
@@ -1207,7 +1240,7 @@ func TopicOnWriterClose(t *Topic, writerInstanceID string, reason error) func(er
 		res(p)
 	}
 }
-func TopicOnWriterCompressMessages(t *Topic, writerInstanceID string, sessionID string, codec int32, firstSeqNo int64, messagesCount int, reason TopicWriterCompressMessagesReason) func(error) {
+func TopicOnWriterCompressMessages(t *Topic, writerInstanceID string, sessionID string, codec int32, firstSeqNo int64, messagesCount int, reason TopicWriterCompressMessagesReason, topic string) func(error) {
 	var p TopicWriterCompressMessagesStartInfo
 	p.WriterInstanceID = writerInstanceID
 	p.SessionID = sessionID
@@ -1215,6 +1248,7 @@ func TopicOnWriterCompressMessages(t *Topic, writerInstanceID string, sessionID
 	p.FirstSeqNo = firstSeqNo
 	p.MessagesCount = messagesCount
 	p.Reason = reason
+	p.Topic = topic
 	res := t.onWriterCompressMessages(p)
 	return func(e error) {
 		var p TopicWriterCompressMessagesDoneInfo
@@ -1222,13 +1256,15 @@ func TopicOnWriterCompressMessages(t *Topic, writerInstanceID string, sessionID
 		res(p)
 	}
 }
-func TopicOnWriterSendMessages(t *Topic, writerInstanceID string, sessionID string, codec int32, firstSeqNo int64, messagesCount int) func(error) {
+func TopicOnWriterSendMessages(t *Topic, writerInstanceID string, sessionID string, codec int32, firstSeqNo int64, messagesCount int, topic string, bytesCount int) func(error) {
 	var p TopicWriterSendMessagesStartInfo
 	p.WriterInstanceID = writerInstanceID
 	p.SessionID = sessionID
 	p.Codec = codec
 	p.FirstSeqNo = firstSeqNo
 	p.MessagesCount = messagesCount
+	p.Topic = topic
+	p.BytesCount = bytesCount
 	res := t.onWriterSendMessages(p)
 	return func(e error) {
 		var p TopicWriterSendMessagesDoneInfo
@@ -1243,3 +1279,11 @@ func TopicOnWriterReadUnknownGrpcMessage(t *Topic, writerInstanceID string, sess
 	p.Error = e
 	t.onWriterReadUnknownGrpcMessage(p)
 }
+func TopicOnWriterQueueChanged(t *Topic, writerInstanceID string, topic string, messagesCount int, bytesCount int) {
+	var p TopicWriterQueueChangedInfo
+	p.WriterInstanceID = writerInstanceID
+	p.Topic = topic
+	p.MessagesCount = messagesCount
+	p.BytesCount = bytesCount
+	t.onWriterQueueChanged(p)
+}